@@ -2,7 +2,7 @@ package main
 
 import (
 	"adventofcode2021/pkg/fileparser"
-	"constraints"
+	"adventofcode2021/pkg/slices"
 	"fmt"
 )
 
@@ -12,23 +12,13 @@ func main() {
 	calculateDiffCounts(measurements, 3)
 }
 
-func calculateDiffCounts[T constraints.Ordered](measurements []T, windowSize int) {
+func calculateDiffCounts[T slices.Number](measurements []T, windowSize int) {
 	incCount := 0
 	decCount := 0
 
-	for i := range measurements {
-		// Ignore the first entry and if the final entry has no full window
-		if i == 0 || i+windowSize-1 >= len(measurements) {
-			continue
-		}
-		var previousWindowValue T
-		var currentWindowValue T
-		for j := 0; j < windowSize; j++ {
-			previousWindowValue += measurements[i-1+j]
-			currentWindowValue += measurements[i+j]
-		}
-
-		if currentWindowValue > previousWindowValue {
+	// Compare each window with the one before it
+	for _, p := range slices.Pairwise(slices.WindowSums(measurements, windowSize)) {
+		if p.Value > p.Key {
 			incCount++
 		} else {
 			decCount++
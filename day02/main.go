@@ -6,8 +6,31 @@ import (
 	"fmt"
 )
 
+type Direction int
+
+const (
+	Forward Direction = iota
+	Up
+	Down
+)
+
+// UnmarshalText allows directions to be read directly from the input file
+func (d *Direction) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "forward":
+		*d = Forward
+	case "up":
+		*d = Up
+	case "down":
+		*d = Down
+	default:
+		return fmt.Errorf("unrecognized step '%s'", text)
+	}
+	return nil
+}
+
 func main() {
-	instructions := fileparser.ReadPairs[string, int]("day02/input.txt", " ")
+	instructions := fileparser.ReadPairs[Direction, int]("day02/input.txt", " ")
 
 	pos, depth := calcBasicLoc(instructions)
 	result := pos * depth
@@ -18,15 +41,15 @@ func main() {
 	fmt.Printf("[Part2] Horizontal pos: %d, Depth: %d, Result: %d (%d instructions)\n", pos, depth, result, len(instructions))
 }
 
-func calcBasicLoc(steps []tuples.Pair[string, int]) (pos, depth int) {
+func calcBasicLoc(steps []tuples.Pair[Direction, int]) (pos, depth int) {
 	pos, depth = 0, 0
 	for _, pair := range steps {
 		switch pair.Key {
-		case "forward":
+		case Forward:
 			pos = pos + pair.Value
-		case "up":
+		case Up:
 			depth = depth - pair.Value
-		case "down":
+		case Down:
 			depth = depth + pair.Value
 		default:
 			panic("unrecognized step")
@@ -35,17 +58,17 @@ func calcBasicLoc(steps []tuples.Pair[string, int]) (pos, depth int) {
 	return pos, depth
 }
 
-func calcAdvancedLoc(steps []tuples.Pair[string, int]) (pos, depth int) {
+func calcAdvancedLoc(steps []tuples.Pair[Direction, int]) (pos, depth int) {
 	pos, depth = 0, 0
 	aim := 0
 	for _, pair := range steps {
 		switch pair.Key {
-		case "forward":
+		case Forward:
 			pos = pos + pair.Value
 			depth = depth + (aim * pair.Value)
-		case "up":
+		case Up:
 			aim = aim - pair.Value
-		case "down":
+		case Down:
 			aim = aim + pair.Value
 		default:
 			panic("unrecognized step")
@@ -2,7 +2,7 @@ package main
 
 import (
 	"adventofcode2021/pkg/fileparser"
-	"adventofcode2021/pkg/maps"
+	"adventofcode2021/pkg/sets"
 	"fmt"
 )
 
@@ -15,34 +15,30 @@ func main() {
 		stats = ProgressDay(stats)
 	}
 	fmt.Println(stats)
-	fmt.Printf("Total fish after 80 days is %d\n", maps.SumValues(stats))
+	fmt.Printf("Total fish after 80 days is %d\n", stats.Total())
 
 	// Progress for up to 256 days
 	for i := 0; i < 256-80; i++ {
 		stats = ProgressDay(stats)
 	}
 	fmt.Println(stats)
-	fmt.Printf("Total fish after 256 days is %d\n", maps.SumValues(stats))
+	fmt.Printf("Total fish after 256 days is %d\n", stats.Total())
 }
 
-func NewFishStats(startingFish []int) map[int]int {
-	stats := make(map[int]int)
-	for _, fish := range startingFish {
-		stats[fish]++
-	}
-	return stats
+func NewFishStats(startingFish []int) sets.Counter[int] {
+	return sets.NewCounterFromSlice(startingFish)
 }
 
-func ProgressDay(stats map[int]int) map[int]int {
-	results := make(map[int]int)
+func ProgressDay(stats sets.Counter[int]) sets.Counter[int] {
+	results := sets.NewCounter[int]()
 	for fish, count := range stats {
 		// For the fish that has reached the end of their timer
 		// reset them to 6, but also spawn new ones of 8
 		if fish == 0 {
-			results[6] += count
-			results[8] += count
+			results.Add(6, count)
+			results.Add(8, count)
 		} else {
-			results[fish-1] += count
+			results.Add(fish-1, count)
 		}
 	}
 	return results
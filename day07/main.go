@@ -10,15 +10,19 @@ import (
 
 func main() {
 	crabPositions := fileparser.ReadCSVLine[int]("day07/input.txt")
-	min, max := slices.MinMax(crabPositions)
 	fuelCalc := NewFuelCalculator()
 
 	basicAttempts := make(map[int]int)
 	advAttempts := make(map[int]int)
 
-	// Loop through each possible alignment position calculating the total fuel used
-	for alignAttempt := min; alignAttempt <= max; alignAttempt++ {
-		basicAttempts[alignAttempt] = slices.SumWeighted(crabPositions, fuelCalc.BasicFuelCostFunc(alignAttempt))
+	// The median minimises the total distance travelled, so is the best position for the basic fuel cost
+	median := slices.Median(crabPositions)
+	basicAttempts[median] = slices.SumWeighted(crabPositions, fuelCalc.BasicFuelCostFunc(median))
+
+	// The advanced fuel cost is minimised within half a position of the mean, so only the whole
+	// positions either side of it need checking
+	mean := slices.MeanInt(crabPositions)
+	for alignAttempt := mean; alignAttempt <= mean+1; alignAttempt++ {
 		advAttempts[alignAttempt] = slices.SumWeighted(crabPositions, fuelCalc.AdvancedFuelCostFunc(alignAttempt))
 	}
 
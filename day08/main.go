@@ -3,6 +3,7 @@ package main
 import (
 	"adventofcode2021/pkg/fileparser"
 	"adventofcode2021/pkg/maps"
+	"adventofcode2021/pkg/sets"
 	"adventofcode2021/pkg/slices"
 	"fmt"
 	"sort"
@@ -114,10 +115,11 @@ func (e *Entry) matchCheckFunc(digit int, expRemoved int, expRemaing int) func(s
 			return false
 		}
 
-		removed, remaining := slices.Divide([]rune(s), func(r rune) bool {
-			return slices.Contains([]rune(e.digitToSignal[digit]), r)
-		})
-		return len(removed) == expRemoved && len(remaining) == expRemaing
+		segments := sets.NewSetFromSlice([]rune(s))
+		digitSegments := sets.NewSetFromSlice([]rune(e.digitToSignal[digit]))
+		removed := segments.Intersection(digitSegments)
+		remaining := segments.Difference(digitSegments)
+		return removed.Len() == expRemoved && remaining.Len() == expRemaing
 	}
 }
 
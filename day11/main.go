@@ -1,6 +1,7 @@
 package main
 
 import (
+	"adventofcode2021/pkg/automaton"
 	"adventofcode2021/pkg/fileparser"
 	"adventofcode2021/pkg/matrices"
 	"fmt"
@@ -16,7 +17,6 @@ func main() {
 }
 
 type Octopi struct {
-	step                 int
 	data                 matrices.IntMatrix[int]
 	FlashesAfter100Steps int
 	Snapshot100Steps     string
@@ -29,65 +29,71 @@ func NewOctopi(data matrices.IntMatrix[int]) *Octopi {
 }
 
 func (o *Octopi) RunSimulation() {
-	// Keep progressing the octopi until we have at least reached 100 steps
-	// and we have seen all the octopi flash at the same time
+	simulation := automaton.New(o.data.Matrix, progressStep)
+
 	syncFlashOccurred := false
 	totalFlashes := 0
-	for !(o.step > 100 && syncFlashOccurred) {
-		o.step++
-		stepFlashes, syncFlashed := o.progressStep()
+	simulation.OnStep(func(s automaton.Snapshot[int]) {
+		// Octopi that have flashed are reset to 0 energy level
+		energy := matrices.NewIntMatrixFromBase(s.Grid)
+		stepFlashes := energy.Count(func(value int) bool { return value == 0 })
 		totalFlashes += stepFlashes
 
-		if o.step == 100 {
+		if s.Generation == 100 {
 			o.FlashesAfter100Steps = totalFlashes
-			o.Snapshot100Steps = o.data.CompactString()
+			o.Snapshot100Steps = energy.CompactString()
 		}
 
-		if syncFlashed && !syncFlashOccurred {
+		if stepFlashes == energy.Size && !syncFlashOccurred {
 			syncFlashOccurred = true
-			o.FirstSyncFlashStep = o.step
-			o.SnapshotFirstSync = o.data.CompactString()
+			o.FirstSyncFlashStep = s.Generation
+			o.SnapshotFirstSync = energy.CompactString()
 		}
-	}
+	})
+
+	// Keep progressing the octopi until we have at least reached 100 steps
+	// and we have seen all the octopi flash at the same time
+	simulation.Run(func(s automaton.Snapshot[int]) bool {
+		return s.Generation > 100 && syncFlashOccurred
+	})
 }
 
-func (o *Octopi) progressStep() (flashes int, allFlashed bool) {
+func progressStep(current, next matrices.Matrix[int]) {
+	energy := matrices.NewIntMatrixFromBase(next)
+
 	// Increase all energy levels by 1
-	o.data.IncrementAll()
+	current.ForEach(func(x, y int, value int) {
+		energy.Set(x, y, value+1)
+	})
 
 	// Check for flashes on all octopi. If a flash is detected, also check neighbouring octopi as their
 	// energy level will increase. Will also reset the octopi to 0 energy level if they do flash
-	total := 0
-	o.data.ForEach(func(x, y int, energy int) {
-		total += o.checkFlash(x, y)
+	energy.ForEach(func(x, y int, value int) {
+		checkFlash(energy, x, y)
 	})
-	return total, o.data.Size == total
 }
 
-func (o *Octopi) checkFlash(x, y int) int {
+// surrounding is every octopus next to another, including diagonals
+var surrounding = matrices.Moore(1)
+
+func checkFlash(energy matrices.IntMatrix[int], x, y int) {
 	// Ignore point if already flashed or doesn't have enough energy
-	if o.data.Get(x, y) < 10 {
-		return 0
+	if energy.Get(x, y) < 10 {
+		return
 	}
 
 	// Reset the octopus energy level as it has flashed
-	o.data.Set(x, y, 0)
+	energy.Set(x, y, 0)
 
 	// This point flashes, so increment all neighbouring octopi (if they haven't flashed)
-	o.data.ForEachNeighbour(true, x, y, func(x1, y1 int) {
-		o.incrementIfNotFlashed(x1, y1)
+	energy.ForEachNeighbour(surrounding, x, y, func(x1, y1 int) {
+		if energy.Get(x1, y1) != 0 {
+			energy.Increment(x1, y1)
+		}
 	})
 
 	// Since we have incremented the neighbours, check if they have now flashed
-	flashes := 1
-	o.data.ForEachNeighbour(true, x, y, func(x1, y1 int) {
-		flashes += o.checkFlash(x1, y1)
+	energy.ForEachNeighbour(surrounding, x, y, func(x1, y1 int) {
+		checkFlash(energy, x1, y1)
 	})
-	return flashes
-}
-
-func (o *Octopi) incrementIfNotFlashed(x, y int) {
-	if o.data.Get(x, y) != 0 {
-		o.data.Increment(x, y)
-	}
 }
@@ -36,18 +36,20 @@ func NewCave(raw string) Cave {
 
 type Solver struct {
 	completeWalkers []*Walker
-	connectedCaves  map[Cave]sets.Set[Cave]
+	connectedCaves  map[Cave]*sets.OrderedSet[Cave]
 }
 
 func NewSolver(segments []tuples.Pair[string, string]) *Solver {
-	connected := make(map[Cave]sets.Set[Cave])
+	// Order connected caves by name so walkers are always expanded in the same order
+	byName := func(a, b Cave) bool { return a.Name < b.Name }
+	connected := make(map[Cave]*sets.OrderedSet[Cave])
 	for _, seg := range segments {
 		cave1, cave2 := NewCave(seg.Key), NewCave(seg.Value)
 		if connected[cave1] == nil {
-			connected[cave1] = sets.NewEmptySet[Cave]()
+			connected[cave1] = sets.NewOrderedSetFunc(byName)
 		}
 		if connected[cave2] == nil {
-			connected[cave2] = sets.NewEmptySet[Cave]()
+			connected[cave2] = sets.NewOrderedSetFunc(byName)
 		}
 		connected[cave1].Add(cave2)
 		connected[cave2].Add(cave1)
@@ -140,14 +142,14 @@ func (s *Solver) Solve(validator validatorFunc) int {
 			}
 			// Check if the walker has any valid moves, and if not kill it
 			validMoves := s.connectedCaves[walker.current].Filter(validator(walker))
-			if len(validMoves) == 0 {
+			if validMoves.Len() == 0 {
 				continue
 			}
 
 			// Generate walkers for each possible valid move. For the last move
 			// reuse the original walker, otherwise, clone a new one
 			for i, validMove := range validMoves.ToSlice() {
-				lastIndex := len(validMoves) - 1
+				lastIndex := validMoves.Len() - 1
 				var nextWalker *Walker
 				if i == lastIndex {
 					nextWalker = walker
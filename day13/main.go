@@ -3,6 +3,7 @@ package main
 import (
 	"adventofcode2021/pkg/convert"
 	"adventofcode2021/pkg/fileparser"
+	"adventofcode2021/pkg/matrices"
 	"adventofcode2021/pkg/sets"
 	"adventofcode2021/pkg/slices"
 	"fmt"
@@ -103,13 +104,17 @@ func (f Fold) Apply(dots sets.Set[Coord]) {
 }
 
 func PrintDots(dots sets.Set[Coord]) {
-	xSelectFunc := func(c Coord) int { return c.X }
-	ySelectFunc := func(c Coord) int { return c.Y }
-	maxX := slices.Max(slices.Map(dots.ToSlice(), xSelectFunc))
-	maxY := slices.Max(slices.Map(dots.ToSlice(), ySelectFunc))
-	for j := 0; j <= maxY; j++ {
-		for i := 0; i <= maxX; i++ {
-			if dots.IsMember(Coord{i, j}) {
+	paper := matrices.NewSparseGrid(false)
+	for dot := range dots {
+		paper.Set(dot.X, dot.Y, true)
+	}
+
+	// Always print from the origin of the paper
+	_, maxPoint, _ := paper.Bounds()
+	image := paper.ToMatrixWithin(matrices.Point{}, maxPoint)
+	for j := 0; j < image.Rows; j++ {
+		for i := 0; i < image.Columns; i++ {
+			if image.Get(i, j) {
 				fmt.Printf("#")
 			} else {
 				fmt.Printf(".")
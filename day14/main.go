@@ -2,7 +2,7 @@ package main
 
 import (
 	"adventofcode2021/pkg/fileparser"
-	"adventofcode2021/pkg/maps"
+	"adventofcode2021/pkg/sets"
 	"fmt"
 )
 
@@ -17,48 +17,42 @@ func main() {
 	}
 
 	// Keep a counter of each pair ( e.g. ABCDE gets stored as AB, BC, CD and DE)
-	counters := make(map[string]int)
+	counters := sets.NewCounter[string]()
 	for i := 0; i < len(template)-1; i++ {
-		counters[template[i:i+2]]++
+		counters.Add(template[i:i+2], 1)
 	}
 
 	// Remember the first element
 	firstLetter := string(template[0])
 
 	for i := 1; i <= 40; i++ {
-		ProgressStep(counters, mapper)
+		counters = ProgressStep(counters, mapper)
 		if i == 10 {
 			stats := Stats(firstLetter, counters)
-			maxLetter, maxVal := maps.MaxValue(stats)
-			minLetter, minVal := maps.MinValue(stats)
-			fmt.Printf("[Part 1] After 10 steps, most common letter (%s) minus least common (%s): %d\n", maxLetter, minLetter, maxVal-minVal)
+			most, least := stats.MostCommon(1)[0], stats.LeastCommon(1)[0]
+			fmt.Printf("[Part 1] After 10 steps, most common letter (%s) minus least common (%s): %d\n", most.Key, least.Key, most.Value-least.Value)
 		}
 
 		if i == 40 {
 			stats := Stats(firstLetter, counters)
-			maxLetter, maxVal := maps.MaxValue(stats)
-			minLetter, minVal := maps.MinValue(stats)
-			fmt.Printf("[Part 2] After 40 steps, most common letter (%s) minus least common (%s): %d\n", maxLetter, minLetter, maxVal-minVal)
+			most, least := stats.MostCommon(1)[0], stats.LeastCommon(1)[0]
+			fmt.Printf("[Part 2] After 40 steps, most common letter (%s) minus least common (%s): %d\n", most.Key, least.Key, most.Value-least.Value)
 		}
 	}
 }
 
-func ProgressStep(counters map[string]int, mapper map[string]string) {
+func ProgressStep(counters sets.Counter[string], mapper map[string]string) sets.Counter[string] {
 	// Map each counter to its 2 new pairs e.g if the mapper indicates AC -> B
-	// then reset the counter for AC to 0 and remember to add the same count to AB and BC
-	additions := make(map[string]int)
+	// then the count for AC is added to both AB and BC
+	result := sets.NewCounter[string]()
 	for oldPair, count := range counters {
-		additions[string(oldPair[0])+mapper[oldPair]] += count
-		additions[mapper[oldPair]+string(oldPair[1])] += count
-		counters[oldPair] = 0
-	}
-
-	for newPair, newCount := range additions {
-		counters[newPair] += newCount
+		result.Add(string(oldPair[0])+mapper[oldPair], count)
+		result.Add(mapper[oldPair]+string(oldPair[1]), count)
 	}
+	return result
 }
 
-func Stats(firstLetter string, counters map[string]int) map[string]int {
+func Stats(firstLetter string, counters sets.Counter[string]) sets.Counter[string] {
 	// Use the count against the last value of each pair, adding the first letter. e.g.
 	// for ABCBC, the pairs are
 	// AB, BC, CB, BC  which translates to
@@ -69,10 +63,10 @@ func Stats(firstLetter string, counters map[string]int) map[string]int {
 	// We need to add a single count of the first letter
 	// as this isn't considered when looking at the last value in the pair
 	// A:1, B:2, C:2
-	result := make(map[string]int)
+	result := sets.NewCounter[string]()
 	for pair, count := range counters {
-		result[string(pair[1])] += count
+		result.Add(string(pair[1]), count)
 	}
-	result[firstLetter]++
+	result.Add(firstLetter, 1)
 	return result
 }
@@ -5,35 +5,16 @@ import (
 	"adventofcode2021/pkg/fileparser"
 	"adventofcode2021/pkg/slices"
 	"fmt"
-	"strings"
 )
 
 func main() {
 	transmission := fileparser.ReadSingles[string]("day16/input.txt")[0]
-	hexToBinary := map[rune]string{
-		'0': "0000",
-		'1': "0001",
-		'2': "0010",
-		'3': "0011",
-		'4': "0100",
-		'5': "0101",
-		'6': "0110",
-		'7': "0111",
-		'8': "1000",
-		'9': "1001",
-		'A': "1010",
-		'B': "1011",
-		'C': "1100",
-		'D': "1101",
-		'E': "1110",
-		'F': "1111",
+	r, err := bits.NewHexReader(transmission)
+	if err != nil {
+		panic(err)
 	}
 
-	// convert hex to bits string
-	parts := slices.Map([]rune(transmission), func(u rune) string { return hexToBinary[u] })
-	binStr := strings.Join(parts, "")
-
-	p := ParsePacket(binStr)
+	p := ParsePacket(r)
 
 	fmt.Println("[Part 1] Sum of version:", VersionCodeSum(p))
 	fmt.Println("[Part 2] Transmission calculation:", Calculate(p))
@@ -49,71 +30,58 @@ type Packet struct {
 	SubPackets   []Packet
 }
 
-func ParsePacket(binStr string) Packet {
-	index := 0
+func readBits(r *bits.Reader, n int) uint64 {
+	val, err := r.ReadBits(n)
+	if err != nil {
+		panic(err)
+	}
+	return val
+}
+
+func ParsePacket(r *bits.Reader) Packet {
+	start := r.Position()
 	p := Packet{}
 
 	// Read version (3 bits)
-	versionStr := binStr[index : index+3]
-	versBits := bits.NewBitField(versionStr)
-	p.Version = versBits.Value
-	index += 3
+	p.Version = readBits(r, 3)
 
 	// Read type ID (3 bits)
-	typeIDStr := binStr[index : index+3]
-	typeIDBits := bits.NewBitField(typeIDStr)
-	p.TypeID = typeIDBits.Value
-	index += 3
+	p.TypeID = readBits(r, 3)
 
 	// Literal value
-	if typeIDBits.Value == 4 {
-		literalBin := ""
+	if p.TypeID == 4 {
 		for {
 			// Read first bit prefix
-			firstBit := binStr[index]
-			index++
-			literalBin += binStr[index : index+4]
-			index += 4
-			if firstBit == '0' {
+			more := readBits(r, 1) == 1
+			p.Literal = p.Literal<<4 | readBits(r, 4)
+			if !more {
 				break
 			}
 		}
-		literalBits := bits.NewBitField(literalBin)
-		p.Literal = literalBits.Value
 	} else {
 		// Operator value
-		lengthTypeIDBits := bits.NewBitField(string(binStr[index]))
-		p.LengthTypeID = lengthTypeIDBits.Value
-		index++
+		p.LengthTypeID = readBits(r, 1)
 
 		// Length in bits
 		if p.LengthTypeID == 0 {
-			lengthValStr := binStr[index : index+15]
-			lengthValBits := bits.NewBitField(lengthValStr)
-			p.Length = lengthValBits.Value
-			index += 15
-			totalBitLength := uint64(0)
-			for totalBitLength < p.Length {
-				packet := ParsePacket(binStr[index:])
-				p.SubPackets = append(p.SubPackets, packet)
-				index += int(packet.BitLength)
-				totalBitLength = slices.SumWeighted(p.SubPackets, func(t Packet) uint64 { return t.BitLength })
+			p.Length = readBits(r, 15)
+			sub, err := r.SubReader(int(p.Length))
+			if err != nil {
+				panic(err)
+			}
+			for sub.Remaining() > 0 {
+				p.SubPackets = append(p.SubPackets, ParsePacket(sub))
 			}
 
 		} else {
 			// Length in packets
-			lengthValStr := binStr[index : index+11]
-			lengthValBits := bits.NewBitField(lengthValStr)
-			p.Length = lengthValBits.Value
-			index += 11
+			p.Length = readBits(r, 11)
 			for len(p.SubPackets) < int(p.Length) {
-				packet := ParsePacket(binStr[index:])
-				p.SubPackets = append(p.SubPackets, packet)
-				index += int(packet.BitLength)
+				p.SubPackets = append(p.SubPackets, ParsePacket(r))
 			}
 		}
 	}
-	p.BitLength = uint64(index)
+	p.BitLength = uint64(r.Position() - start)
 	return p
 }
 
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"adventofcode2021/pkg/combinatorics"
 	"adventofcode2021/pkg/fileparser"
 	"fmt"
 	"strconv"
@@ -12,26 +13,14 @@ func main() {
 	sum := Sum(nums)
 	fmt.Println("[Part 1] Magnitude of sum of numbers is:", sum.Magnitude())
 
-	max := len(nums)
 	maxVal := 0
-	// Consider every permutation of each number
-	for i := 0; i < max; i++ {
-		for j := 0; j < max; j++ {
-			if i == j {
-				continue
-			}
-			// Check addition both ways
-			testij := nums[i].Add(nums[j])
-			if val := testij.Magnitude(); val > maxVal {
-				maxVal = val
-			}
-
-			testji := nums[j].Add(nums[i])
-			if val := testji.Magnitude(); val > maxVal {
-				maxVal = val
-			}
+	// Consider every ordered pair of numbers, as addition isn't commutative
+	combinatorics.Pairs(nums, func(a, b *SnailPair) bool {
+		if val := a.Add(b).Magnitude(); val > maxVal {
+			maxVal = val
 		}
-	}
+		return true
+	})
 	fmt.Println("[Part 2] Maximum mangitude of adding 2 numbers: ", maxVal)
 }
 
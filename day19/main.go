@@ -1,7 +1,10 @@
 package main
 
 import (
+	"adventofcode2021/pkg/combinatorics"
 	"adventofcode2021/pkg/fileparser"
+	"adventofcode2021/pkg/matrices"
+	"adventofcode2021/pkg/sets"
 	"adventofcode2021/pkg/slices"
 	"fmt"
 	"strconv"
@@ -16,12 +19,20 @@ func main() {
 	unalignedScanners := scanners[1:]          // Scanners where we don't know how to orientate to origin
 	alignedScanners := []*Scanner{scanners[0]} // Scanners where we have know how to orientate to origin
 
+	// Group scanners that can be aligned with each other, every scanner needs to join the origin's group
+	origin := scanners[0].label
+	groups := sets.NewDisjointSet[int]()
+	for _, scanner := range scanners {
+		groups.Add(scanner.label)
+	}
+
 	type attempt struct{ unaligned, aligned int }
 	tries := make(map[attempt]struct{})
 	// Loop tthough all unaligned scanners and attempt to align with
 	// already aligned scanners
-	for len(unalignedScanners) > 0 {
+	for groups.Size(origin) < len(scanners) {
 		fmt.Printf("Aligning %d scanner(s)...\n", len(unalignedScanners))
+		progressed := false
 		for _, unaligned := range unalignedScanners {
 			for _, aligned := range alignedScanners {
 				// ignore this attempt as we've tried previously
@@ -31,10 +42,14 @@ func main() {
 				}
 				tries[thisAttempt] = struct{}{}
 				if ok := AttemptToAlign(unaligned, aligned); ok {
+					progressed = groups.Union(aligned.label, unaligned.label) || progressed
 					break
 				}
 			}
 		}
+		if !progressed {
+			panic(fmt.Sprintf("unable to align %d scanner(s)", len(unalignedScanners)))
+		}
 		// Reset lists based on what has been aligned
 		alignedScanners, unalignedScanners = slices.Divide(scanners, IsAligned)
 	}
@@ -43,7 +58,7 @@ func main() {
 	finalBeacons := make(map[Coord]struct{})
 	for _, scanner := range alignedScanners {
 		for _, beacon := range scanner.beacons {
-			finalBeacons[AddCoords(scanner.rotateToOrigin(beacon), *scanner.transformToOrigin)] = struct{}{}
+			finalBeacons[scanner.rotateToOrigin(beacon).Add(*scanner.transformToOrigin)] = struct{}{}
 		}
 	}
 	fmt.Println("[Part 1] Total number of beacons:", len(finalBeacons))
@@ -51,14 +66,12 @@ func main() {
 	// Loop through combinations of 2 scanners and calculate distance between them
 	// based on how they are transformed to origin
 	maxDist := 0
-	for _, s1 := range alignedScanners {
-		for _, s2 := range alignedScanners {
-			dist := Distance(s1, s2)
-			if dist > maxDist {
-				maxDist = dist
-			}
+	combinatorics.Combinations(alignedScanners, 2, func(pair []*Scanner) bool {
+		if dist := Distance(pair[0], pair[1]); dist > maxDist {
+			maxDist = dist
 		}
-	}
+		return true
+	})
 	fmt.Println("[Part 2] Max distance between scanners:", maxDist)
 }
 
@@ -85,7 +98,7 @@ func ParseScanners(lines []string) []*Scanner {
 	return scanners
 }
 
-type Coord struct{ x, y, z int }
+type Coord = matrices.Point3
 
 type Rotation func(Coord) Coord
 
@@ -108,13 +121,13 @@ func NewScanner(data []string) *Scanner {
 	for _, coordStr := range data[1:] {
 		if coordStr != "" {
 			parts := fileparser.SplitTrim[int](coordStr, ",")
-			coords = append(coords, Coord{parts[0], parts[1], parts[2]})
+			coords = append(coords, Coord{X: parts[0], Y: parts[1], Z: parts[2]})
 		}
 	}
 	s := &Scanner{label: label, beacons: coords}
 	// Define the origin scanner as aligned at 0,0,0 (no rotatio required)
 	if label == 0 {
-		s.transformToOrigin = &Coord{0, 0, 0}
+		s.transformToOrigin = &Coord{}
 		s.rotateToOrigin = func(c Coord) Coord { return c }
 	}
 	return s
@@ -123,42 +136,42 @@ func NewScanner(data []string) *Scanner {
 func (s *Scanner) String() string {
 	out := fmt.Sprintf("scanner %d (beacons:%d)\n\n", s.label, len(s.beacons))
 	for _, b := range s.beacons {
-		out += fmt.Sprintf("%5d%5d%5d\n", b.x, b.y, b.z)
+		out += fmt.Sprintf("%5d%5d%5d\n", b.X, b.Y, b.Z)
 	}
 	return out
 }
 
 // Defines all possible rotation mappings of a cube (24 possible mapping)
 var rotations = []Rotation{
-	func(c Coord) Coord { return Coord{c.x, c.y, c.z} },
-	func(c Coord) Coord { return Coord{c.x, -1 * c.z, c.y} },
-	func(c Coord) Coord { return Coord{c.x, -1 * c.y, -1 * c.z} },
-	func(c Coord) Coord { return Coord{c.x, c.z, -1 * c.y} },
-
-	func(c Coord) Coord { return Coord{-1 * c.y, c.x, c.z} },
-	func(c Coord) Coord { return Coord{c.z, c.x, c.y} },
-	func(c Coord) Coord { return Coord{c.y, c.x, -1 * c.z} },
-	func(c Coord) Coord { return Coord{-1 * c.z, c.x, -1 * c.y} },
-
-	func(c Coord) Coord { return Coord{-1 * c.x, -1 * c.y, c.z} },
-	func(c Coord) Coord { return Coord{-1 * c.x, -1 * c.z, -1 * c.y} },
-	func(c Coord) Coord { return Coord{-1 * c.x, c.y, -1 * c.z} },
-	func(c Coord) Coord { return Coord{-1 * c.x, c.z, c.y} },
-
-	func(c Coord) Coord { return Coord{c.y, -1 * c.x, c.z} },
-	func(c Coord) Coord { return Coord{c.z, -1 * c.x, -1 * c.y} },
-	func(c Coord) Coord { return Coord{-1 * c.y, -1 * c.x, -1 * c.z} },
-	func(c Coord) Coord { return Coord{-1 * c.z, -1 * c.x, c.y} },
-
-	func(c Coord) Coord { return Coord{-1 * c.z, c.y, c.x} },
-	func(c Coord) Coord { return Coord{c.y, c.z, c.x} },
-	func(c Coord) Coord { return Coord{c.z, -1 * c.y, c.x} },
-	func(c Coord) Coord { return Coord{-1 * c.y, -1 * c.z, c.x} },
-
-	func(c Coord) Coord { return Coord{-1 * c.z, -1 * c.y, -1 * c.x} },
-	func(c Coord) Coord { return Coord{-1 * c.y, c.z, -1 * c.x} },
-	func(c Coord) Coord { return Coord{c.z, c.y, -1 * c.x} },
-	func(c Coord) Coord { return Coord{c.y, -1 * c.z, -1 * c.x} },
+	func(c Coord) Coord { return Coord{X: c.X, Y: c.Y, Z: c.Z} },
+	func(c Coord) Coord { return Coord{X: c.X, Y: -c.Z, Z: c.Y} },
+	func(c Coord) Coord { return Coord{X: c.X, Y: -c.Y, Z: -c.Z} },
+	func(c Coord) Coord { return Coord{X: c.X, Y: c.Z, Z: -c.Y} },
+
+	func(c Coord) Coord { return Coord{X: -c.Y, Y: c.X, Z: c.Z} },
+	func(c Coord) Coord { return Coord{X: c.Z, Y: c.X, Z: c.Y} },
+	func(c Coord) Coord { return Coord{X: c.Y, Y: c.X, Z: -c.Z} },
+	func(c Coord) Coord { return Coord{X: -c.Z, Y: c.X, Z: -c.Y} },
+
+	func(c Coord) Coord { return Coord{X: -c.X, Y: -c.Y, Z: c.Z} },
+	func(c Coord) Coord { return Coord{X: -c.X, Y: -c.Z, Z: -c.Y} },
+	func(c Coord) Coord { return Coord{X: -c.X, Y: c.Y, Z: -c.Z} },
+	func(c Coord) Coord { return Coord{X: -c.X, Y: c.Z, Z: c.Y} },
+
+	func(c Coord) Coord { return Coord{X: c.Y, Y: -c.X, Z: c.Z} },
+	func(c Coord) Coord { return Coord{X: c.Z, Y: -c.X, Z: -c.Y} },
+	func(c Coord) Coord { return Coord{X: -c.Y, Y: -c.X, Z: -c.Z} },
+	func(c Coord) Coord { return Coord{X: -c.Z, Y: -c.X, Z: c.Y} },
+
+	func(c Coord) Coord { return Coord{X: -c.Z, Y: c.Y, Z: c.X} },
+	func(c Coord) Coord { return Coord{X: c.Y, Y: c.Z, Z: c.X} },
+	func(c Coord) Coord { return Coord{X: c.Z, Y: -c.Y, Z: c.X} },
+	func(c Coord) Coord { return Coord{X: -c.Y, Y: -c.Z, Z: c.X} },
+
+	func(c Coord) Coord { return Coord{X: -c.Z, Y: -c.Y, Z: -c.X} },
+	func(c Coord) Coord { return Coord{X: -c.Y, Y: c.Z, Z: -c.X} },
+	func(c Coord) Coord { return Coord{X: c.Z, Y: c.Y, Z: -c.X} },
+	func(c Coord) Coord { return Coord{X: c.Y, Y: -c.Z, Z: -c.X} },
 }
 
 func AttemptToAlign(unaligned *Scanner, aligned *Scanner) bool {
@@ -181,7 +194,7 @@ func AttemptToAlign(unaligned *Scanner, aligned *Scanner) bool {
 
 		// If so, this rotation should apply to the unaligned beacon
 		unaligned.rotateToOrigin = r
-		transform := AddCoords(shift, *aligned.transformToOrigin)
+		transform := shift.Add(*aligned.transformToOrigin)
 		unaligned.transformToOrigin = &transform
 		return true
 	}
@@ -194,12 +207,12 @@ func CompareBeacons(beacons1 []Coord, beacons2 []Coord) (Coord, bool) {
 	for _, b1 := range beacons1 {
 		for _, b2 := range beacons2 {
 			matched := 0
-			testShift := SubtractCoords(b1, b2)
+			testShift := b1.Sub(b2)
 
 			// Loop through the second set of beacons and apply the test shift
 			// counting how many beacons line up exactly with beacons in the first set
 			for _, test := range beacons2 {
-				shifted := AddCoords(test, testShift)
+				shifted := test.Add(testShift)
 				if slices.Contains(beacons1, shifted) {
 					matched++
 				}
@@ -213,27 +226,9 @@ func CompareBeacons(beacons1 []Coord, beacons2 []Coord) (Coord, bool) {
 			}
 		}
 	}
-	return Coord{0, 0, 0}, false
-}
-
-func AddCoords(c1 Coord, c2 Coord) Coord {
-	return Coord{c1.x + c2.x, c1.y + c2.y, c1.z + c2.z}
-}
-
-func SubtractCoords(c1 Coord, c2 Coord) Coord {
-	return Coord{c1.x - c2.x, c1.y - c2.y, c1.z - c2.z}
+	return Coord{}, false
 }
 
 func Distance(s1 *Scanner, s2 *Scanner) int {
-	dx := Mod(s1.transformToOrigin.x - s2.transformToOrigin.x)
-	dy := Mod(s1.transformToOrigin.y - s2.transformToOrigin.y)
-	dz := Mod(s1.transformToOrigin.z - s2.transformToOrigin.z)
-	return dx + dy + dz
-}
-
-func Mod(x int) int {
-	if x > 0 {
-		return x
-	}
-	return x * -1
+	return s1.transformToOrigin.Manhattan(*s2.transformToOrigin)
 }
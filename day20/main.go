@@ -1,7 +1,6 @@
 package main
 
 import (
-	"adventofcode2021/pkg/bits"
 	"adventofcode2021/pkg/fileparser"
 	"adventofcode2021/pkg/matrices"
 	"fmt"
@@ -21,88 +20,59 @@ func main() {
 }
 
 type Enhancer struct {
-	alg                     map[string]string
-	darkMapPixel            string
-	flipDarkMapAfterEnhance bool
-	trench                  matrices.Matrix[string]
+	alg    []bool
+	trench *matrices.SparseGrid[bool]
 }
 
 func NewEnhancer(lines []string) *Enhancer {
-	alg := newAlg(lines[0])
+	image := fileparser.ReadCharMatrixFromLines[string](lines[2:])
+	trench := matrices.NewSparseGrid(false) // Pixels outside the image start dark
+	image.ForEach(func(x, y int, value string) {
+		trench.Set(x, y, value == "#")
+	})
 	return &Enhancer{
-		alg:                     alg,
-		darkMapPixel:            ".",                     // Tile to use when extending the map
-		flipDarkMapAfterEnhance: alg["........."] == "#", // Indicates that after an enhance, a pixel woth all dark pixels will become bright
-		trench:                  fileparser.ReadCharMatrixFromLines[string](lines[2:]),
+		alg:    newAlg(lines[0]),
+		trench: trench,
 	}
 }
 
-func newAlg(data string) map[string]string {
+func newAlg(data string) []bool {
 	if len(data) != 512 {
 		panic("unexpected algorithm length")
 	}
-	alg := make(map[string]string)
-	for i := 0; i < 512; i++ {
-		// Encode the number into a 9 bit value
-		b := bits.NewBitFieldForVal(uint64(i), 9)
-		key := ""
-		for i := 0; i < b.Length; i++ {
-			pixelVal := "."
-			if b.Get(i) {
-				pixelVal = "#"
-			}
-			key += pixelVal
-		}
-		alg[key] = string(data[i])
+	alg := make([]bool, len(data))
+	for i, c := range data {
+		alg[i] = c == '#'
 	}
 	return alg
 }
 
 func (e *Enhancer) Enhance() {
-	// Expand matrix by one row/column on all sides
-	e.trench = e.trench.Expand(1, 1, 1, 1, e.darkMapPixel)
-	result := matrices.NewMatrix[string](e.trench.Rows, e.trench.Columns)
-
-	// Calculate algorithm key based on all neighbours
-	e.trench.ForEach(func(x, y int, value string) {
-		key := e.SurroundingPixelsKey(x, y)
-		result.Set(x, y, e.alg[key])
-	})
-	e.trench = result
-
-	// Flip the pixel to use for out of bounds if the algorithm key flips them
-	if e.flipDarkMapAfterEnhance {
-		if e.darkMapPixel == "#" {
-			e.darkMapPixel = "."
-		} else {
-			e.darkMapPixel = "#"
-		}
+	// Every pixel outside of the image has the same surroundings, so will all become the same pixel
+	background := e.trench.Background()
+	newBackground := e.alg[0]
+	if background {
+		newBackground = e.alg[len(e.alg)-1]
 	}
-}
-
-func (e *Enhancer) SurroundingPixelsKey(x, y int) string {
-	return e.Pixel(x-1, y-1) +
-		e.Pixel(x, y-1) +
-		e.Pixel(x+1, y-1) +
-		e.Pixel(x-1, y) +
-		e.Pixel(x, y) +
-		e.Pixel(x+1, y) +
-		e.Pixel(x-1, y+1) +
-		e.Pixel(x, y+1) +
-		e.Pixel(x+1, y+1)
-}
 
-func (e *Enhancer) Pixel(x, y int) string {
-	if x < 0 || y < 0 || x > e.trench.Columns-1 || y > e.trench.Rows-1 {
-		return e.darkMapPixel
-	}
-	return e.trench.Get(x, y)
+	// Calculate algorithm key based on all neighbours, including one pixel beyond the image on all sides
+	minPoint, maxPoint, _ := e.trench.Bounds()
+	topLeft := minPoint.Add(matrices.Point{X: -1, Y: -1})
+	image := e.trench.ToMatrixWithin(topLeft, maxPoint.Add(matrices.Point{X: 1, Y: 1}))
+	enhanced := matrices.ApplyBoolKernel(image, 3, matrices.ConstantBoundary(background), func(key int) bool {
+		return e.alg[key]
+	})
+	e.trench = matrices.NewSparseGridFromMatrixAt(enhanced, topLeft, newBackground)
 }
 
+// CountPixels returns the number of lit pixels, which is infinite while the background is lit
 func (e *Enhancer) CountPixels() int {
+	if e.trench.Background() {
+		panic("infinite number of lit pixels while the background is lit")
+	}
 	count := 0
-	e.trench.ForEach(func(x, y int, value string) {
-		if value == "#" {
+	e.trench.ForEach(func(x, y int, value bool) {
+		if value {
 			count++
 		}
 	})
@@ -110,9 +80,14 @@ func (e *Enhancer) CountPixels() int {
 }
 
 func (e *Enhancer) PrintField() {
-	for j := 0; j < e.trench.Rows; j++ {
-		for i := 0; i < e.trench.Columns; i++ {
-			fmt.Printf(e.trench.Get(i, j))
+	field, _ := e.trench.ToMatrix()
+	for j := 0; j < field.Rows; j++ {
+		for i := 0; i < field.Columns; i++ {
+			if field.Get(i, j) {
+				fmt.Printf("#")
+			} else {
+				fmt.Printf(".")
+			}
 		}
 		fmt.Println()
 	}
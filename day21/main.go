@@ -1,8 +1,10 @@
 package main
 
 import (
+	"adventofcode2021/pkg/combinatorics"
 	"adventofcode2021/pkg/fileparser"
 	"adventofcode2021/pkg/maps"
+	"adventofcode2021/pkg/sets"
 	"fmt"
 )
 
@@ -30,7 +32,7 @@ func main() {
 }
 
 type Die interface {
-	RollScore() []int // Represents the values that the die can provide on any given turn
+	RollScore() sets.Counter[int] // Represents the values that the die can provide on any given turn, with the number of ways to roll each
 }
 
 type DeterministicDie struct{ lastVal int }
@@ -39,13 +41,13 @@ func NewDeterministicDie() *DeterministicDie {
 	return &DeterministicDie{lastVal: 1}
 }
 
-func (d *DeterministicDie) RollScore() []int {
+func (d *DeterministicDie) RollScore() sets.Counter[int] {
 	result := (d.lastVal + 1) * 3
 	d.lastVal += 3
 	if d.lastVal > 101 {
 		d.lastVal -= 100
 	}
-	return []int{result}
+	return sets.NewCounterFromSlice([]int{result})
 }
 
 type DiracDie struct{}
@@ -54,16 +56,9 @@ func NewDiracDie() *DiracDie {
 	return &DiracDie{}
 }
 
-func (d *DiracDie) RollScore() []int {
-	result := []int{}
-	for r1 := 1; r1 <= 3; r1++ {
-		for r2 := 1; r2 <= 3; r2++ {
-			for r3 := 1; r3 <= 3; r3++ {
-				result = append(result, r1+r2+r3)
-			}
-		}
-	}
-	return result
+func (d *DiracDie) RollScore() sets.Counter[int] {
+	// Each turn rolls the 3 sided die 3 times, splitting into 27 universes
+	return combinatorics.DiceDistribution(3, 3)
 }
 
 type Game struct {
@@ -147,10 +142,10 @@ func (g *Game) Play() {
 				continue
 			}
 			running = true
-			for _, dieVal := range g.die.RollScore() {
+			for dieVal, ways := range g.die.RollScore() {
 				newPos := ModPos(s.Pos + dieVal)
 				newState := PlayerGameState{Pos: newPos, Score: s.Score + newPos}
-				g.CurrentPlayer().IncrementState(g.turn, newState, c)
+				g.CurrentPlayer().IncrementState(g.turn, newState, c*ways)
 			}
 		}
 
@@ -2,6 +2,7 @@ package main
 
 import (
 	"adventofcode2021/pkg/fileparser"
+	"adventofcode2021/pkg/matrices"
 	"adventofcode2021/pkg/sets"
 	"adventofcode2021/pkg/slices"
 	"fmt"
@@ -17,10 +18,9 @@ func main() {
 	fmt.Println("[Part 2] Total cubes on for all instructions:", RunSteps(instructions).SumWeighted(SizeFunc))
 }
 
+// Box is a cuboid of cubes, including the min corner but excluding the max corner
 type Box struct {
-	minX, maxX int
-	minY, maxY int
-	minZ, maxZ int
+	min, max matrices.Point3
 }
 
 type RebootStep struct {
@@ -45,23 +45,19 @@ func NewRebootStep(line string) RebootStep {
 	yParts := fileparser.SplitTrim[int](coordsY, "..")
 	zParts := fileparser.SplitTrim[int](coordsZ, "..")
 
-	result.box.minX, result.box.maxX = xParts[0], xParts[1]+1
-	result.box.minY, result.box.maxY = yParts[0], yParts[1]+1
-	result.box.minZ, result.box.maxZ = zParts[0], zParts[1]+1
+	result.box.min = matrices.Point3{X: xParts[0], Y: yParts[0], Z: zParts[0]}
+	result.box.max = matrices.Point3{X: xParts[1] + 1, Y: yParts[1] + 1, Z: zParts[1] + 1}
 	return result
 }
 
 func SmallStep(step RebootStep) bool {
-	if step.box.minX < -50 || step.box.maxX > 51 ||
-		step.box.minY < -50 || step.box.maxY > 51 ||
-		step.box.minZ < -50 || step.box.maxZ > 51 {
-		return false
-	}
-	return true
+	region := Box{min: matrices.Point3{X: -50, Y: -50, Z: -50}, max: matrices.Point3{X: 51, Y: 51, Z: 51}}
+	return Inside(region, step.box)
 }
 
 func (b Box) Size() int {
-	return (b.maxX - b.minX) * (b.maxY - b.minY) * (b.maxZ - b.minZ)
+	d := b.max.Sub(b.min)
+	return d.X * d.Y * d.Z
 }
 
 func SizeFunc(b Box) int {
@@ -75,9 +71,9 @@ func Decompose(b1 Box, b2 Box) []Box {
 	if intersect == nil {
 		return []Box{b1}
 	}
-	x := []int{b1.minX, b1.maxX, b2.minX, b2.maxX}
-	y := []int{b1.minY, b1.maxY, b2.minY, b2.maxY}
-	z := []int{b1.minZ, b1.maxZ, b2.minZ, b2.maxZ}
+	x := []int{b1.min.X, b1.max.X, b2.min.X, b2.max.X}
+	y := []int{b1.min.Y, b1.max.Y, b2.min.Y, b2.max.Y}
+	z := []int{b1.min.Z, b1.max.Z, b2.min.Z, b2.max.Z}
 	sort.Ints(x)
 	sort.Ints(y)
 	sort.Ints(z)
@@ -86,7 +82,10 @@ func Decompose(b1 Box, b2 Box) []Box {
 	for i := 0; i < 3; i++ {
 		for j := 0; j < 3; j++ {
 			for k := 0; k < 3; k++ {
-				b := Box{x[i], x[i+1], y[j], y[j+1], z[k], z[k+1]}
+				b := Box{
+					min: matrices.Point3{X: x[i], Y: y[j], Z: z[k]},
+					max: matrices.Point3{X: x[i+1], Y: y[j+1], Z: z[k+1]},
+				}
 				if Inside(b1, b) && b != *intersect {
 					inBox1 = append(inBox1, b)
 				}
@@ -97,9 +96,7 @@ func Decompose(b1 Box, b2 Box) []Box {
 }
 
 func Inside(outer, inner Box) bool {
-	return outer.minX <= inner.minX && outer.maxX >= inner.maxX &&
-		outer.minY <= inner.minY && outer.maxY >= inner.maxY &&
-		outer.minZ <= inner.minZ && outer.maxZ >= inner.maxZ
+	return outer.min.Min(inner.min) == outer.min && outer.max.Max(inner.max) == outer.max
 }
 
 func RunSteps(steps []RebootStep) sets.Set[Box] {
@@ -125,31 +122,9 @@ func RunSteps(steps []RebootStep) sets.Set[Box] {
 }
 
 func Intersect(b1 Box, b2 Box) *Box {
-	b := &Box{
-		minX: Max(b1.minX, b2.minX),
-		maxX: Min(b1.maxX, b2.maxX),
-		minY: Max(b1.minY, b2.minY),
-		maxY: Min(b1.maxY, b2.maxY),
-		minZ: Max(b1.minZ, b2.minZ),
-		maxZ: Min(b1.maxZ, b2.maxZ),
-	}
-
-	if b.maxX >= b.minX && b.maxY >= b.minY && b.maxZ >= b.minZ {
+	b := &Box{min: b1.min.Max(b2.min), max: b1.max.Min(b2.max)}
+	if b.max.Max(b.min) == b.max {
 		return b
 	}
 	return nil
 }
-
-func Min(a, b int) int {
-	if a > b {
-		return b
-	}
-	return a
-}
-
-func Max(a, b int) int {
-	if a < b {
-		return b
-	}
-	return a
-}
@@ -1,9 +1,9 @@
 package main
 
 import (
+	"adventofcode2021/pkg/convert"
 	"adventofcode2021/pkg/fileparser"
 	"fmt"
-	"strconv"
 )
 
 func main() {
@@ -123,6 +123,11 @@ func ApplyOps(s State, ops []Op, input []int64) State {
 
 func NewOp(line string) Op {
 	parts := fileparser.SplitTrim[string](line, " ")
+	var operand func(s State) int64
+	if len(parts) > 2 {
+		operand = NewOperand(parts[2])
+	}
+
 	var op Op
 	switch parts[0] {
 	case "inp":
@@ -132,24 +137,24 @@ func NewOp(line string) Op {
 		}}
 	case "add":
 		op = Op{Func: func(s State) State {
-			return s.Set(parts[1], s.Get(parts[1])+s.GetOrConvert(parts[2]))
+			return s.Set(parts[1], s.Get(parts[1])+operand(s))
 		}}
 	case "mul":
 		op = Op{Func: func(s State) State {
-			return s.Set(parts[1], s.Get(parts[1])*s.GetOrConvert(parts[2]))
+			return s.Set(parts[1], s.Get(parts[1])*operand(s))
 		}}
 	case "div":
 		op = Op{Func: func(s State) State {
-			return s.Set(parts[1], s.Get(parts[1])/s.GetOrConvert(parts[2]))
+			return s.Set(parts[1], s.Get(parts[1])/operand(s))
 		}}
 	case "mod":
 		op = Op{Func: func(s State) State {
-			return s.Set(parts[1], s.Get(parts[1])%s.GetOrConvert(parts[2]))
+			return s.Set(parts[1], s.Get(parts[1])%operand(s))
 		}}
 	case "eql":
 		op = Op{Func: func(s State) State {
 			result := int64(0)
-			if s.Get(parts[1]) == s.GetOrConvert(parts[2]) {
+			if s.Get(parts[1]) == operand(s) {
 				result = 1
 			}
 			return s.Set(parts[1], result)
@@ -193,15 +198,14 @@ func (s State) Set(d string, val int64) State {
 	return result
 }
 
-func (s State) GetOrConvert(d string) int64 {
+// NewOperand creates a function returning the value of an operand, either a dimension of the state or
+// a literal. Literals are converted once here rather than every time the instruction runs
+func NewOperand(d string) func(s State) int64 {
 	switch d {
 	case "x", "y", "z", "w":
-		return s.Get(d)
+		return func(s State) int64 { return s.Get(d) }
 	default:
-		val, err := strconv.Atoi(d)
-		if err != nil {
-			panic(err)
-		}
-		return int64(val)
+		val := convert.Apply[int64](d)
+		return func(s State) int64 { return val }
 	}
 }
@@ -1,67 +1,36 @@
 package main
 
 import (
+	"adventofcode2021/pkg/automaton"
 	"adventofcode2021/pkg/fileparser"
 	"adventofcode2021/pkg/matrices"
 	"fmt"
 )
 
-type Pos struct{ x, y int }
-
 func main() {
-	seabed := fileparser.ReadCharMatrix[string]("day25/input.txt")
-	count := 0
-	for {
-		count++
-		movedRight := MoveCucumbersRight(seabed)
-		movedDown := MoveCucumbersDown(seabed)
-		if !movedRight && !movedDown {
-			break
-		}
-	}
-	fmt.Println("[Part 1] Steps until cucumbers stop moving:", count)
-}
-
-func MoveCucumbers(icon string, seabed matrices.Matrix[string], move func(x Pos) Pos) bool {
-	moved := false
-	moves := make(map[Pos]Pos)
-	seabed.ForEach(func(x, y int, value string) {
-		if value != icon {
-			return
-		}
-
-		newPos := move(Pos{x, y})
-		if seabed.Get(newPos.x, newPos.y) == "." {
-			moves[Pos{x, y}] = newPos
-			moved = true
-		}
-	})
-
-	for start, end := range moves {
-		seabed.Set(start.x, start.y, ".")
-		seabed.Set(end.x, end.y, icon)
-	}
-	return moved
-}
+	seabed := fileparser.ReadCharMatrix[string]("day25/input.txt").WithWrap(true)
 
-func MoveCucumbersRight(seabed matrices.Matrix[string]) bool {
-	moveFunc := func(p Pos) Pos {
-		newX := p.x + 1
-		if newX >= seabed.Columns {
-			newX = 0
-		}
-		return Pos{newX, p.y}
-	}
-	return MoveCucumbers(">", seabed, moveFunc)
+	// Each step the east facing herd moves, then the south facing herd
+	herds := automaton.New(seabed, MoveCucumbers(">", 1, 0), MoveCucumbers("v", 0, 1))
+	result := herds.Run(automaton.FixedPoint[string]())
+	fmt.Println("[Part 1] Steps until cucumbers stop moving:", result.Generation)
 }
 
-func MoveCucumbersDown(seabed matrices.Matrix[string]) bool {
-	moveFunc := func(p Pos) Pos {
-		newY := p.y + 1
-		if newY >= seabed.Rows {
-			newY = 0
+// MoveCucumbers generates a step where every cucumber of the herd moves forward if the space in front is empty
+func MoveCucumbers(icon string, dx, dy int) automaton.StepFunc[string] {
+	return automaton.CellRule(func(x, y int, seabed matrices.Matrix[string]) string {
+		switch seabed.Get(x, y) {
+		case ".":
+			// Empty space is filled if a cucumber behind is able to move
+			if behind, _ := seabed.GetOffset(x, y, -dx, -dy); behind == icon {
+				return icon
+			}
+		case icon:
+			// Cucumber moves if the space in front is empty
+			if ahead, _ := seabed.GetOffset(x, y, dx, dy); ahead == "." {
+				return "."
+			}
 		}
-		return Pos{p.x, newY}
-	}
-	return MoveCucumbers("v", seabed, moveFunc)
+		return seabed.Get(x, y)
+	})
 }
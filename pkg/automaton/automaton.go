@@ -0,0 +1,183 @@
+package automaton
+
+import (
+	"adventofcode2021/pkg/matrices"
+)
+
+// StepFunc computes the next generation from the current generation. The next matrix is a reused buffer
+// holding stale values, so every element must be written
+type StepFunc[T comparable] func(current, next matrices.Matrix[T])
+
+// CellRule creates a step function where each element of the next generation is computed independently
+// from the current generation
+func CellRule[T comparable](rule func(x, y int, current matrices.Matrix[T]) T) StepFunc[T] {
+	return func(current, next matrices.Matrix[T]) {
+		current.ForEach(func(x, y int, value T) {
+			next.Set(x, y, rule(x, y, current))
+		})
+	}
+}
+
+// Snapshot describes the state of the automaton after a step. Every snapshot has its own copy of the
+// grid, so can be kept and modified independently of the automaton and any other snapshot
+type Snapshot[T comparable] struct {
+	Generation int
+	Changed    int
+	Grid       matrices.Matrix[T]
+}
+
+// StopCondition indicates if the automaton should stop running after the step described by the snapshot
+type StopCondition[T comparable] func(s Snapshot[T]) bool
+
+// Result describes the final state of a run. If a cycle was detected CycleLength is the number of generations
+// between repeated states, starting from CycleStart
+type Result[T comparable] struct {
+	Snapshot[T]
+	CycleStart, CycleLength int
+}
+
+// Automaton repeatedly applies step functions to a matrix, double buffering between generations
+type Automaton[T comparable] struct {
+	current, next matrices.Matrix[T]
+	before        matrices.Matrix[T] // Copy of the previous generation, only needed with several step functions
+	steps         []StepFunc[T]
+	generation    int
+	hooks         []func(Snapshot[T])
+	seen          map[uint64][]seenState[T]
+}
+
+// seenState records a previous generation for cycle detection
+type seenState[T comparable] struct {
+	generation int
+	grid       matrices.Matrix[T]
+}
+
+// New creates an automaton starting from a copy of the initial matrix. Each generation applies every
+// step function in order
+func New[T comparable](initial matrices.Matrix[T], steps ...StepFunc[T]) *Automaton[T] {
+	a := &Automaton[T]{
+		current: initial.Clone(),
+		next:    initial.Clone(),
+		steps:   steps,
+	}
+	if len(steps) > 1 {
+		a.before = initial.Clone()
+	}
+	return a
+}
+
+// Current returns the current generation, which should not be modified
+func (a *Automaton[T]) Current() matrices.Matrix[T] {
+	return a.current
+}
+
+// Generation returns the number of steps that have been run
+func (a *Automaton[T]) Generation() int {
+	return a.generation
+}
+
+// OnStep registers a hook that is called with a snapshot after every step
+func (a *Automaton[T]) OnStep(hook func(s Snapshot[T])) {
+	a.hooks = append(a.hooks, hook)
+}
+
+// DetectCycles will stop runs when a previously seen state is repeated. States are grouped by hash, then
+// compared in full so a hash collision can't report a false cycle
+func (a *Automaton[T]) DetectCycles() {
+	a.seen = make(map[uint64][]seenState[T])
+	a.remember(a.current.Clone())
+}
+
+// remember records the grid as the state of the current generation, returning the generation it was
+// first seen at if it has been seen before
+func (a *Automaton[T]) remember(grid matrices.Matrix[T]) (int, bool) {
+	hash := matrices.Hash(grid)
+	for _, previous := range a.seen[hash] {
+		if matrices.Equal(previous.grid, grid) {
+			return previous.generation, true
+		}
+	}
+	a.seen[hash] = append(a.seen[hash], seenState[T]{generation: a.generation, grid: grid})
+	return 0, false
+}
+
+// Step runs a single generation and returns the number of elements that differ from the previous
+// generation. Elements changed by one step function and reverted by a later one are not counted
+func (a *Automaton[T]) Step() int {
+	// With a single step function the previous generation is left untouched in the next buffer,
+	// otherwise it is overwritten by the later step functions so needs copying first
+	before := a.current
+	if len(a.steps) > 1 {
+		a.current.ForEach(func(x, y int, value T) {
+			a.before.Set(x, y, value)
+		})
+		before = a.before
+	}
+
+	for _, step := range a.steps {
+		step(a.current, a.next)
+		a.current, a.next = a.next, a.current
+	}
+	a.generation++
+
+	changed := 0
+	a.current.ForEach(func(x, y int, value T) {
+		if before.Get(x, y) != value {
+			changed++
+		}
+	})
+	return changed
+}
+
+// Run keeps stepping until the stop condition is met, or a cycle is detected
+func (a *Automaton[T]) Run(stop StopCondition[T]) Result[T] {
+	for {
+		snapshot := Snapshot[T]{Changed: a.Step(), Generation: a.generation}
+		// Each hook gets its own copy of the grid, so changes made by one can't be seen by the others,
+		// the stop condition or the result
+		for _, hook := range a.hooks {
+			hookSnapshot := snapshot
+			hookSnapshot.Grid = a.current.Clone()
+			hook(hookSnapshot)
+		}
+		snapshot.Grid = a.current.Clone()
+
+		if a.seen != nil {
+			// The result grid can be modified by the caller, so store a separate copy
+			if start, ok := a.remember(a.current.Clone()); ok {
+				return Result[T]{Snapshot: snapshot, CycleStart: start, CycleLength: a.generation - start}
+			}
+		}
+
+		if stop(snapshot) {
+			return Result[T]{Snapshot: snapshot}
+		}
+	}
+}
+
+// FixedPoint stops once a step makes no changes
+func FixedPoint[T comparable]() StopCondition[T] {
+	return func(s Snapshot[T]) bool { return s.Changed == 0 }
+}
+
+// AfterSteps stops once the provided number of generations have been run
+func AfterSteps[T comparable](n int) StopCondition[T] {
+	return func(s Snapshot[T]) bool { return s.Generation >= n }
+}
+
+// When stops once the predicate matches the current generation
+func When[T comparable](predicate func(grid matrices.Matrix[T]) bool) StopCondition[T] {
+	return func(s Snapshot[T]) bool { return predicate(s.Grid) }
+}
+
+// AnyOf stops once any of the conditions are met
+func AnyOf[T comparable](conditions ...StopCondition[T]) StopCondition[T] {
+	return func(s Snapshot[T]) bool {
+		for _, condition := range conditions {
+			if condition(s) {
+				return true
+			}
+		}
+		return false
+	}
+}
@@ -1,7 +1,6 @@
 package bits
 
 import (
-	"bytes"
 	"strconv"
 )
 
@@ -45,14 +44,11 @@ func (b BitField) Get(pos int) bool {
 }
 
 func (b BitField) Invert() BitField {
-	var inverted bytes.Buffer
-	for _, c := range b.str {
-		if c == '0' {
-			inverted.WriteString("1")
-		} else {
-			inverted.WriteString("0")
-		}
-	}
-	result := NewBitField(inverted.String())
-	return result
+	mask := uint64(1)<<b.Length - 1
+	return NewBitFieldForVal(^b.Value&mask, b.Length)
+}
+
+// ToBitVector converts the bit field to a BitVector
+func (b BitField) ToBitVector() BitVector {
+	return NewBitVectorForVal(b.Value, b.Length)
 }
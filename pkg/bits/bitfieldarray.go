@@ -2,66 +2,83 @@ package bits
 
 import (
 	"adventofcode2021/pkg/slices"
-	"bytes"
 	"fmt"
 )
 
 type BitFieldArray []BitField
 
-// MostCommon create a BitField where each flag in each postion represets the most common flag for that position
-// across all entries in the array
-func (b BitFieldArray) MostCommon() BitField {
+// ColumnCounts returns how many entries have the flag set in each position, computed in a single pass
+func (b BitFieldArray) ColumnCounts() []int {
 	if len(b) == 0 {
 		panic("No entries in array")
 	}
 	counts := make([]int, b[0].Length)
-
-	// Count how many 1s are in each position
-	for pos := 0; pos < len(counts); pos++ {
-		for _, reading := range b {
-			if reading.Get(pos) {
-				counts[pos]++
-			}
-		}
+	for _, reading := range b {
+		countWordBits(counts, reading.Value, reading.Length-1)
 	}
+	return counts
+}
 
-	// Create a bitfield where each 1 indicates that position had half or more
-	var resultBytes bytes.Buffer
+// Common creates a BitField where each flag in each position represents the most or least common flag for
+// that position across all entries in the array. Ties are resolved using the tie flag
+func (b BitFieldArray) Common(c Commonality, tie bool) BitField {
+	counts := b.ColumnCounts()
+	var val uint64
 	for _, count := range counts {
-		if 2*count >= len(b) {
-			resultBytes.WriteString("1")
-		} else {
-			resultBytes.WriteString("0")
+		val <<= 1
+		if SelectFlag(count, len(b), c, tie) {
+			val |= 1
 		}
 	}
+	return NewBitFieldForVal(val, len(counts))
+}
 
-	result := NewBitField(resultBytes.String())
-	return result
+// MostCommon create a BitField where each flag in each postion represets the most common flag for that position
+// across all entries in the array, ties select 1
+func (b BitFieldArray) MostCommon() BitField {
+	return b.Common(MostCommon, true)
+}
+
+// LeastCommon create a BitField where each flag in each postion represets the least common flag for that position
+// across all entries in the array, ties select 0
+func (b BitFieldArray) LeastCommon() BitField {
+	return b.Common(LeastCommon, false)
 }
 
 // FilterByPos reduces the bit field entries to only ones where the flag in the provided position matches
 // either most common or least common flag in that position
 func (b BitFieldArray) FilterByPos(pos int, useCommon bool) BitFieldArray {
-	criteria := b.MostCommon()
-	if !useCommon {
-		criteria = criteria.Invert()
-	}
+	c, tie := commonalityFor(useCommon)
+	ones := slices.CountIf(b, func(field BitField) bool { return field.Get(pos) })
+	flag := SelectFlag(ones, len(b), c, tie)
 
 	return slices.Filter(b, func(field BitField) bool {
-		return field.Get(pos) == criteria.Get(pos)
+		return field.Get(pos) == flag
 	})
 }
 
-// ReduceToRating iterates through each position in the bit field and reduces the entries by position until
-// there is only one result left
-func (b BitFieldArray) ReduceToRating(useCommon bool) BitField {
-	possibleResults := b
-	for pos := 0; pos < b[0].Length; pos++ {
-		possibleResults = possibleResults.FilterByPos(pos, useCommon)
-		if val, ok := slices.IsSingle(possibleResults); ok {
-			return val
-		}
+// Rating iterates through each position in the bit field and reduces the entries by position until
+// there is only one result left. Only the remaining entries are counted at each position
+func (b BitFieldArray) Rating(c Commonality, tie bool) BitField {
+	if len(b) == 0 {
+		panic("No entries in array")
+	}
+	if val, ok := reduceToRating(b, b[0].Length, c, tie); ok {
+		return val
 	}
+	panic(fmt.Sprintf("reduction failed to find 1 result %v", b))
+}
 
-	panic(fmt.Sprintf("reduction failed to find 1 result %v", possibleResults))
+// ReduceToRating reduces the entries using the most common (ties select 1) or least common (ties select 0)
+// flags until there is only one result left
+func (b BitFieldArray) ReduceToRating(useCommon bool) BitField {
+	return b.Rating(commonalityFor(useCommon))
+}
+
+// commonalityFor converts the useCommon flag to the commonality and tie breaker used by the diagnostics
+func commonalityFor(useCommon bool) (Commonality, bool) {
+	if useCommon {
+		return MostCommon, true
+	}
+	return LeastCommon, false
 }
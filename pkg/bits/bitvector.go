@@ -0,0 +1,304 @@
+package bits
+
+import (
+	"fmt"
+	mathbits "math/bits"
+	"strconv"
+	"strings"
+)
+
+const wordSize = 64
+
+// BitVector is a bit field of any length. Positions are numbered from the left, matching BitField, so
+// position 0 is the most significant bit
+type BitVector struct {
+	words  []uint64
+	Length int
+}
+
+// NewBitVectorOfLength creates a bit vector of the provided length with all bits cleared
+func NewBitVectorOfLength(length int) BitVector {
+	if length < 0 {
+		panic(fmt.Sprintf("invalid bit vector length %d", length))
+	}
+	return BitVector{words: make([]uint64, (length+wordSize-1)/wordSize), Length: length}
+}
+
+// NewBitVector creates a bit vector from a binary string e.g. "10110"
+func NewBitVector(bin string) BitVector {
+	b := NewBitVectorOfLength(len(bin))
+	for pos, c := range bin {
+		switch c {
+		case '0':
+		case '1':
+			b.Set(pos)
+		default:
+			panic(fmt.Sprintf("invalid binary character '%c' in '%s'", c, bin))
+		}
+	}
+	return b
+}
+
+// NewBitVectorFromHex creates a bit vector from a hex string, each hex character provides 4 bits
+func NewBitVectorFromHex(hex string) BitVector {
+	b := NewBitVectorOfLength(len(hex) * 4)
+	for i, c := range hex {
+		nibble, err := strconv.ParseUint(string(c), 16, 8)
+		if err != nil {
+			panic(err)
+		}
+		for j := 0; j < 4; j++ {
+			if nibble&(1<<(3-j)) != 0 {
+				b.Set(i*4 + j)
+			}
+		}
+	}
+	return b
+}
+
+// NewBitVectorForVal creates a bit vector of the provided length from the lowest bits of the value
+func NewBitVectorForVal(val uint64, length int) BitVector {
+	b := NewBitVectorOfLength(length)
+	if len(b.words) > 0 {
+		b.words[0] = val
+		b.clearPadding()
+	}
+	return b
+}
+
+// index converts a position (from the left) to the word and bit offset (from the least significant bit)
+func (b BitVector) index(pos int) (int, uint) {
+	if pos < 0 || pos >= b.Length {
+		panic(fmt.Sprintf("bit position %d out of range for length %d", pos, b.Length))
+	}
+	bit := b.Length - pos - 1
+	return bit / wordSize, uint(bit % wordSize)
+}
+
+// clearPadding ensures bits beyond the length of the vector are always zero
+func (b BitVector) clearPadding() {
+	if extra := b.Length % wordSize; extra != 0 {
+		b.words[len(b.words)-1] &= (1 << extra) - 1
+	}
+}
+
+// Get returns if the bit at the position is set
+func (b BitVector) Get(pos int) bool {
+	w, bit := b.index(pos)
+	return b.words[w]&(1<<bit) != 0
+}
+
+// Set will set the bit at the position
+func (b BitVector) Set(pos int) {
+	w, bit := b.index(pos)
+	b.words[w] |= 1 << bit
+}
+
+// Clear will clear the bit at the position
+func (b BitVector) Clear(pos int) {
+	w, bit := b.index(pos)
+	b.words[w] &^= 1 << bit
+}
+
+// Flip will toggle the bit at the position
+func (b BitVector) Flip(pos int) {
+	w, bit := b.index(pos)
+	b.words[w] ^= 1 << bit
+}
+
+// SetTo will set or clear the bit at the position based on the provided value
+func (b BitVector) SetTo(pos int, val bool) {
+	if val {
+		b.Set(pos)
+	} else {
+		b.Clear(pos)
+	}
+}
+
+// Len returns the number of bits in the vector
+func (b BitVector) Len() int {
+	return b.Length
+}
+
+// Clone creates a copy of the bit vector that can be modified independently
+func (b BitVector) Clone() BitVector {
+	words := make([]uint64, len(b.words))
+	copy(words, b.words)
+	return BitVector{words: words, Length: b.Length}
+}
+
+// Equal returns true if both vectors have the same length and bits
+func (b BitVector) Equal(other BitVector) bool {
+	if b.Length != other.Length {
+		return false
+	}
+	for i, w := range b.words {
+		if w != other.words[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (b BitVector) combine(other BitVector, op func(x, y uint64) uint64) BitVector {
+	if b.Length != other.Length {
+		panic(fmt.Sprintf("mismatching bit vector lengths %d and %d", b.Length, other.Length))
+	}
+	result := NewBitVectorOfLength(b.Length)
+	for i := range result.words {
+		result.words[i] = op(b.words[i], other.words[i])
+	}
+	return result
+}
+
+// And returns a new vector with bits set in both vectors
+func (b BitVector) And(other BitVector) BitVector {
+	return b.combine(other, func(x, y uint64) uint64 { return x & y })
+}
+
+// Or returns a new vector with bits set in either vector
+func (b BitVector) Or(other BitVector) BitVector {
+	return b.combine(other, func(x, y uint64) uint64 { return x | y })
+}
+
+// Xor returns a new vector with bits set in only one of the vectors
+func (b BitVector) Xor(other BitVector) BitVector {
+	return b.combine(other, func(x, y uint64) uint64 { return x ^ y })
+}
+
+// Not returns a new vector with every bit inverted
+func (b BitVector) Not() BitVector {
+	result := NewBitVectorOfLength(b.Length)
+	for i, w := range b.words {
+		result.words[i] = ^w
+	}
+	result.clearPadding()
+	return result
+}
+
+// ShiftLeft returns a new vector with the bits moved n positions to the left, bits moved past the start are lost
+func (b BitVector) ShiftLeft(n int) BitVector {
+	if n < 0 {
+		return b.ShiftRight(-n)
+	}
+	result := NewBitVectorOfLength(b.Length)
+	wordShift, bitShift := n/wordSize, uint(n%wordSize)
+	for i := len(result.words) - 1; i >= wordShift; i-- {
+		result.words[i] = b.words[i-wordShift] << bitShift
+		if bitShift != 0 && i-wordShift-1 >= 0 {
+			result.words[i] |= b.words[i-wordShift-1] >> (wordSize - bitShift)
+		}
+	}
+	result.clearPadding()
+	return result
+}
+
+// ShiftRight returns a new vector with the bits moved n positions to the right, bits moved past the end are lost
+func (b BitVector) ShiftRight(n int) BitVector {
+	if n < 0 {
+		return b.ShiftLeft(-n)
+	}
+	result := NewBitVectorOfLength(b.Length)
+	wordShift, bitShift := n/wordSize, uint(n%wordSize)
+	for i := 0; i+wordShift < len(b.words); i++ {
+		result.words[i] = b.words[i+wordShift] >> bitShift
+		if bitShift != 0 && i+wordShift+1 < len(b.words) {
+			result.words[i] |= b.words[i+wordShift+1] << (wordSize - bitShift)
+		}
+	}
+	return result
+}
+
+// PopCount returns the number of set bits
+func (b BitVector) PopCount() int {
+	count := 0
+	for _, w := range b.words {
+		count += mathbits.OnesCount64(w)
+	}
+	return count
+}
+
+// LeadingZeros returns the number of cleared bits before the first set bit
+func (b BitVector) LeadingZeros() int {
+	padding := len(b.words)*wordSize - b.Length
+	for i := len(b.words) - 1; i >= 0; i-- {
+		if b.words[i] != 0 {
+			return (len(b.words)-1-i)*wordSize + mathbits.LeadingZeros64(b.words[i]) - padding
+		}
+	}
+	return b.Length
+}
+
+// TrailingZeros returns the number of cleared bits after the last set bit
+func (b BitVector) TrailingZeros() int {
+	for i, w := range b.words {
+		if w != 0 {
+			return i*wordSize + mathbits.TrailingZeros64(w)
+		}
+	}
+	return b.Length
+}
+
+// Uint64 returns the numeric value of the vector, vectors over 64 bits can not be represented
+func (b BitVector) Uint64() uint64 {
+	if b.Length > wordSize {
+		panic(fmt.Sprintf("bit vector of length %d does not fit in 64 bits", b.Length))
+	}
+	if len(b.words) == 0 {
+		return 0
+	}
+	return b.words[0]
+}
+
+// ToBitField converts the vector to a BitField, vectors over 64 bits can not be represented
+func (b BitVector) ToBitField() BitField {
+	return NewBitFieldForVal(b.Uint64(), b.Length)
+}
+
+// String returns the vector as a binary string
+func (b BitVector) String() string {
+	var out strings.Builder
+	out.Grow(b.Length)
+	for pos := 0; pos < b.Length; pos++ {
+		if b.Get(pos) {
+			out.WriteByte('1')
+		} else {
+			out.WriteByte('0')
+		}
+	}
+	return out.String()
+}
+
+// Hex returns the vector as an uppercase hex string, vectors that aren't a multiple of 4 bits are padded
+// with leading zeros
+func (b BitVector) Hex() string {
+	const hexChars = "0123456789ABCDEF"
+	padding := (4 - b.Length%4) % 4
+	padded := NewBitVectorOfLength(b.Length + padding)
+	copy(padded.words, b.words)
+
+	var out strings.Builder
+	out.Grow(padded.Length / 4)
+	for pos := 0; pos < padded.Length; pos += 4 {
+		nibble := 0
+		for j := 0; j < 4; j++ {
+			nibble <<= 1
+			if padded.Get(pos + j) {
+				nibble |= 1
+			}
+		}
+		out.WriteByte(hexChars[nibble])
+	}
+	return out.String()
+}
+
+// UnmarshalText allows bit vectors to be read from binary strings
+func (b *BitVector) UnmarshalText(text []byte) error {
+	for _, c := range text {
+		if c != '0' && c != '1' {
+			return fmt.Errorf("invalid binary string '%s'", text)
+		}
+	}
+	*b = NewBitVector(string(text))
+	return nil
+}
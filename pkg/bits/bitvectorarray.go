@@ -0,0 +1,77 @@
+package bits
+
+import (
+	"adventofcode2021/pkg/slices"
+	"fmt"
+)
+
+type BitVectorArray []BitVector
+
+// ColumnCounts returns how many entries have the flag set in each position, computed in a single pass
+func (b BitVectorArray) ColumnCounts() []int {
+	if len(b) == 0 {
+		panic("No entries in array")
+	}
+	counts := make([]int, b[0].Length)
+	for _, reading := range b {
+		for i, word := range reading.words {
+			countWordBits(counts, word, reading.Length-1-i*wordSize)
+		}
+	}
+	return counts
+}
+
+// Common creates a BitVector where each flag in each position represents the most or least common flag for
+// that position across all entries in the array. Ties are resolved using the tie flag
+func (b BitVectorArray) Common(c Commonality, tie bool) BitVector {
+	counts := b.ColumnCounts()
+	result := NewBitVectorOfLength(len(counts))
+	for pos, count := range counts {
+		if SelectFlag(count, len(b), c, tie) {
+			result.Set(pos)
+		}
+	}
+	return result
+}
+
+// MostCommon create a BitVector where each flag in each postion represets the most common flag for that position
+// across all entries in the array, ties select 1
+func (b BitVectorArray) MostCommon() BitVector {
+	return b.Common(MostCommon, true)
+}
+
+// LeastCommon create a BitVector where each flag in each postion represets the least common flag for that position
+// across all entries in the array, ties select 0
+func (b BitVectorArray) LeastCommon() BitVector {
+	return b.Common(LeastCommon, false)
+}
+
+// FilterByPos reduces the bit vector entries to only ones where the flag in the provided position matches
+// either most common or least common flag in that position
+func (b BitVectorArray) FilterByPos(pos int, useCommon bool) BitVectorArray {
+	c, tie := commonalityFor(useCommon)
+	ones := slices.CountIf(b, func(field BitVector) bool { return field.Get(pos) })
+	flag := SelectFlag(ones, len(b), c, tie)
+
+	return slices.Filter(b, func(field BitVector) bool {
+		return field.Get(pos) == flag
+	})
+}
+
+// Rating iterates through each position in the bit vector and reduces the entries by position until
+// there is only one result left. Only the remaining entries are counted at each position
+func (b BitVectorArray) Rating(c Commonality, tie bool) BitVector {
+	if len(b) == 0 {
+		panic("No entries in array")
+	}
+	if val, ok := reduceToRating(b, b[0].Length, c, tie); ok {
+		return val
+	}
+	panic(fmt.Sprintf("reduction failed to find 1 result %v", b))
+}
+
+// ReduceToRating reduces the entries using the most common (ties select 1) or least common (ties select 0)
+// flags until there is only one result left
+func (b BitVectorArray) ReduceToRating(useCommon bool) BitVector {
+	return b.Rating(commonalityFor(useCommon))
+}
@@ -0,0 +1,64 @@
+package bits
+
+import (
+	mathbits "math/bits"
+)
+
+// Commonality indicates whether the most or least common flag is selected for a position
+type Commonality int
+
+const (
+	MostCommon Commonality = iota
+	LeastCommon
+)
+
+// SelectFlag picks the flag for a position given how many of the total entries have that flag set.
+// When both flags are equally common the tie flag is selected
+func SelectFlag(ones, total int, c Commonality, tie bool) bool {
+	zeros := total - ones
+	if ones == zeros {
+		return tie
+	}
+	return (ones > zeros) == (c == MostCommon)
+}
+
+// countWordBits adds the set bits of a word to the counts, where the least significant bit of the word is
+// the position provided
+func countWordBits(counts []int, word uint64, lsbPos int) {
+	for ; word != 0; word &= word - 1 {
+		counts[lsbPos-mathbits.TrailingZeros64(word)]++
+	}
+}
+
+// reduceToRating narrows the readings one position at a time, counting only the remaining readings for the
+// current position, until a single reading is left
+func reduceToRating[T interface{ Get(pos int) bool }](readings []T, length int, c Commonality, tie bool) (T, bool) {
+	remaining := make([]T, len(readings))
+	copy(remaining, readings)
+
+	for pos := 0; pos < length && len(remaining) > 1; pos++ {
+		ones := 0
+		for _, reading := range remaining {
+			if reading.Get(pos) {
+				ones++
+			}
+		}
+		flag := SelectFlag(ones, len(remaining), c, tie)
+
+		// Keep matching readings in place
+		kept := 0
+		for _, reading := range remaining {
+			if reading.Get(pos) == flag {
+				remaining[kept] = reading
+				kept++
+			}
+		}
+		remaining = remaining[:kept]
+	}
+
+	if len(remaining) != 1 {
+		var blank T
+		return blank, false
+	}
+	return remaining[0], true
+}
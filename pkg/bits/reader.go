@@ -0,0 +1,102 @@
+package bits
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+// ErrUnderrun is returned when attempting to read more bits than remain in the reader
+var ErrUnderrun = errors.New("not enough bits remaining")
+
+// Reader reads values of any bit width from a byte slice, most significant bit first
+type Reader struct {
+	data       []byte
+	start, end int
+	pos        int
+}
+
+// NewReader creates a reader over all bits in the provided data
+func NewReader(data []byte) *Reader {
+	return &Reader{data: data, end: len(data) * 8}
+}
+
+// NewHexReader creates a reader over the bits represented by a hex string, each hex character provides 4 bits
+func NewHexReader(hexStr string) (*Reader, error) {
+	padded := hexStr
+	if len(padded)%2 != 0 {
+		padded += "0"
+	}
+	data, err := hex.DecodeString(padded)
+	if err != nil {
+		return nil, err
+	}
+	r := NewReader(data)
+	r.end = len(hexStr) * 4
+	return r, nil
+}
+
+// Position returns the number of bits read since the start of the reader
+func (r *Reader) Position() int {
+	return r.pos - r.start
+}
+
+// Remaining returns the number of bits that can still be read
+func (r *Reader) Remaining() int {
+	return r.end - r.pos
+}
+
+// ReadBits reads the next n bits (up to 64) as an unsigned value
+func (r *Reader) ReadBits(n int) (uint64, error) {
+	if n < 0 || n > 64 {
+		return 0, fmt.Errorf("unable to read %d bits into a uint64", n)
+	}
+	if n > r.Remaining() {
+		return 0, fmt.Errorf("reading %d bits with %d remaining: %w", n, r.Remaining(), ErrUnderrun)
+	}
+
+	var result uint64
+	for n > 0 {
+		// Read as many bits as possible from the current byte
+		offset := r.pos % 8
+		take := 8 - offset
+		if take > n {
+			take = n
+		}
+		b := r.data[r.pos/8] >> (8 - offset - take) & (1<<take - 1)
+		result = result<<take | uint64(b)
+		r.pos += take
+		n -= take
+	}
+	return result, nil
+}
+
+// ReadBool reads the next bit, returning true if it is set
+func (r *Reader) ReadBool() (bool, error) {
+	val, err := r.ReadBits(1)
+	return val == 1, err
+}
+
+// ReadBitVector reads the next n bits into a BitVector, allowing reads wider than 64 bits
+func (r *Reader) ReadBitVector(n int) (BitVector, error) {
+	if n < 0 || n > r.Remaining() {
+		return BitVector{}, fmt.Errorf("reading %d bits with %d remaining: %w", n, r.Remaining(), ErrUnderrun)
+	}
+	result := NewBitVectorOfLength(n)
+	for pos := 0; pos < n; pos++ {
+		if set, _ := r.ReadBool(); set {
+			result.Set(pos)
+		}
+	}
+	return result, nil
+}
+
+// SubReader creates a reader limited to the next n bits and advances this reader past them
+func (r *Reader) SubReader(n int) (*Reader, error) {
+	if n < 0 || n > r.Remaining() {
+		return nil, fmt.Errorf("limiting to %d bits with %d remaining: %w", n, r.Remaining(), ErrUnderrun)
+	}
+	sub := &Reader{data: r.data, start: r.pos, end: r.pos + n, pos: r.pos}
+	r.pos += n
+	return sub, nil
+}
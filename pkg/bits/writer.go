@@ -0,0 +1,105 @@
+package bits
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Writer appends values of any bit width, most significant bit first, the inverse of Reader
+type Writer struct {
+	data   []byte
+	length int
+}
+
+// NewWriter creates an empty writer
+func NewWriter() *Writer {
+	return &Writer{}
+}
+
+// Len returns the number of bits written
+func (w *Writer) Len() int {
+	return w.length
+}
+
+// WriteBits appends the lowest n bits (up to 64) of the value
+func (w *Writer) WriteBits(val uint64, n int) error {
+	if n < 0 || n > 64 {
+		return fmt.Errorf("unable to write %d bits from a uint64", n)
+	}
+	if n < 64 && val>>n != 0 {
+		return fmt.Errorf("value %d does not fit in %d bits", val, n)
+	}
+
+	for n > 0 {
+		if w.length%8 == 0 {
+			w.data = append(w.data, 0)
+		}
+		// Write as many bits as possible into the current byte
+		free := 8 - w.length%8
+		take := free
+		if take > n {
+			take = n
+		}
+		b := byte(val>>(n-take)) & (1<<take - 1)
+		w.data[len(w.data)-1] |= b << (free - take)
+		w.length += take
+		n -= take
+	}
+	return nil
+}
+
+// WriteBool appends a single bit, set if the value is true
+func (w *Writer) WriteBool(val bool) {
+	var bit uint64
+	if val {
+		bit = 1
+	}
+	_ = w.WriteBits(bit, 1)
+}
+
+// WriteBitVector appends every bit of the vector
+func (w *Writer) WriteBitVector(b BitVector) {
+	for pos := 0; pos < b.Length; pos++ {
+		w.WriteBool(b.Get(pos))
+	}
+}
+
+// padTo appends zero bits until the length is a multiple of the provided boundary
+func (w *Writer) padTo(boundary int) {
+	for w.length%boundary != 0 {
+		w.WriteBool(false)
+	}
+}
+
+// PadToNibble appends zero bits until the length is a multiple of 4
+func (w *Writer) PadToNibble() {
+	w.padTo(4)
+}
+
+// PadToByte appends zero bits until the length is a multiple of 8
+func (w *Writer) PadToByte() {
+	w.padTo(8)
+}
+
+// Bytes returns the written bits, the final byte is padded with zero bits
+func (w *Writer) Bytes() []byte {
+	result := make([]byte, len(w.data))
+	copy(result, w.data)
+	return result
+}
+
+// Hex returns the written bits as an uppercase hex string, the final nibble is padded with zero bits
+func (w *Writer) Hex() string {
+	const hexChars = "0123456789ABCDEF"
+	var out strings.Builder
+	nibbles := (w.length + 3) / 4
+	out.Grow(nibbles)
+	for i := 0; i < nibbles; i++ {
+		b := w.data[i/2]
+		if i%2 == 0 {
+			b >>= 4
+		}
+		out.WriteByte(hexChars[b&0xF])
+	}
+	return out.String()
+}
@@ -0,0 +1,169 @@
+package combinatorics
+
+import (
+	"adventofcode2021/pkg/sets"
+	"fmt"
+)
+
+// Each generator below is lazy, calling op once per result as it is produced. Returning false from op
+// stops the generation early. Slices passed to op are reused between calls, so must be copied if they
+// are kept
+
+// Permutations generates every ordering of the elements, in lexicographic order of their positions
+func Permutations[T any](source []T, op func(perm []T) bool) {
+	n := len(source)
+	indices := make([]int, n)
+	for i := range indices {
+		indices[i] = i
+	}
+	perm := make([]T, n)
+	for {
+		for i, index := range indices {
+			perm[i] = source[index]
+		}
+		if !op(perm) {
+			return
+		}
+
+		// Find the rightmost position that can be increased, then swap in the next largest index
+		// after it and reverse the tail so it is back in ascending order
+		i := n - 2
+		for i >= 0 && indices[i] > indices[i+1] {
+			i--
+		}
+		if i < 0 {
+			return
+		}
+		j := n - 1
+		for indices[j] < indices[i] {
+			j--
+		}
+		indices[i], indices[j] = indices[j], indices[i]
+		for l, r := i+1, n-1; l < r; l, r = l+1, r-1 {
+			indices[l], indices[r] = indices[r], indices[l]
+		}
+	}
+}
+
+// Combinations generates every selection of k elements, keeping their original order, in
+// lexicographic order of their positions
+func Combinations[T any](source []T, k int, op func(combo []T) bool) {
+	n := len(source)
+	if k < 0 || k > n {
+		return
+	}
+	indices := make([]int, k)
+	for i := range indices {
+		indices[i] = i
+	}
+	combo := make([]T, k)
+	for {
+		for i, index := range indices {
+			combo[i] = source[index]
+		}
+		if !op(combo) {
+			return
+		}
+
+		// Find the rightmost index that isn't at its final position, move it on and reset those after it
+		i := k - 1
+		for i >= 0 && indices[i] == n-k+i {
+			i--
+		}
+		if i < 0 {
+			return
+		}
+		indices[i]++
+		for j := i + 1; j < k; j++ {
+			indices[j] = indices[j-1] + 1
+		}
+	}
+}
+
+// Pairs generates every ordered pair of elements at different positions, so each pair is produced
+// both ways round
+func Pairs[T any](source []T, op func(a, b T) bool) {
+	for i, a := range source {
+		for j, b := range source {
+			if i != j && !op(a, b) {
+				return
+			}
+		}
+	}
+}
+
+// CartesianProduct generates every tuple taking one element from each of the inputs, with the last
+// input changing fastest. Nothing is generated if any input is empty
+func CartesianProduct[T any](inputs [][]T, op func(tuple []T) bool) {
+	for _, input := range inputs {
+		if len(input) == 0 {
+			return
+		}
+	}
+	indices := make([]int, len(inputs))
+	tuple := make([]T, len(inputs))
+	for {
+		for i, index := range indices {
+			tuple[i] = inputs[i][index]
+		}
+		if !op(tuple) {
+			return
+		}
+
+		// Advance like an odometer, carrying into earlier inputs as later ones wrap around
+		i := len(inputs) - 1
+		for i >= 0 {
+			indices[i]++
+			if indices[i] < len(inputs[i]) {
+				break
+			}
+			indices[i] = 0
+			i--
+		}
+		if i < 0 {
+			return
+		}
+	}
+}
+
+// PowerSet generates every subset of the elements, keeping their original order, starting with the
+// empty set. Up to 62 elements are supported
+func PowerSet[T any](source []T, op func(subset []T) bool) {
+	n := len(source)
+	if n > 62 {
+		panic(fmt.Sprintf("power set of %d elements is too large", n))
+	}
+	subset := make([]T, 0, n)
+	for mask := int64(0); mask < 1<<n; mask++ {
+		subset = subset[:0]
+		for i := 0; i < n; i++ {
+			if mask&(1<<i) != 0 {
+				subset = append(subset, source[i])
+			}
+		}
+		if !op(subset) {
+			return
+		}
+	}
+}
+
+// DiceDistribution counts the number of ways each total can be rolled with the provided number of
+// dice, each numbered 1 to sides
+func DiceDistribution(dice, sides int) sets.Counter[int] {
+	if dice < 0 || sides < 1 {
+		panic(fmt.Sprintf("invalid dice %dd%d", dice, sides))
+	}
+	// Build up the totals one die at a time
+	result := sets.NewCounter[int]()
+	result.Add(0, 1)
+	for d := 0; d < dice; d++ {
+		next := sets.NewCounter[int]()
+		for total, ways := range result {
+			for face := 1; face <= sides; face++ {
+				next.Add(total+face, ways)
+			}
+		}
+		result = next
+	}
+	return result
+}
@@ -2,43 +2,123 @@ package convert
 
 import (
 	"adventofcode2021/pkg/bits"
+	"encoding"
+	"fmt"
+	"reflect"
 	"strconv"
+	"sync"
+	"unicode/utf8"
 )
 
-type Convertable interface {
-	string | int | bits.BitField
+// Convertable is any type that a string can be converted into. Support is resolved when the converter
+// is requested: built in types, types with a registered parse function and types implementing
+// encoding.TextUnmarshaler are all supported
+type Convertable interface{}
+
+var (
+	registryLock sync.RWMutex
+	registry     = map[reflect.Type]interface{}{}
+)
+
+func init() {
+	Register(func(x string) (string, error) { return x, nil })
+	Register(strconv.Atoi)
+	Register(func(x string) (int64, error) { return strconv.ParseInt(x, 10, 64) })
+	Register(func(x string) (int32, error) {
+		val, err := strconv.ParseInt(x, 10, 32)
+		return int32(val), err
+	})
+	Register(func(x string) (uint64, error) { return strconv.ParseUint(x, 10, 64) })
+	Register(func(x string) (float64, error) { return strconv.ParseFloat(x, 64) })
+	Register(strconv.ParseBool)
+	Register(charConvert)
+	Register(func(x string) (bits.BitField, error) { return bits.NewBitField(x), nil })
+}
+
+// Char is a single character. As rune is an alias of int32, which is converted as a number, use Char to
+// convert single character strings
+type Char rune
+
+func charConvert(x string) (Char, error) {
+	if utf8.RuneCountInString(x) != 1 {
+		return 0, fmt.Errorf("expecting a single character, '%s'", x)
+	}
+	r, _ := utf8.DecodeRuneInString(x)
+	return Char(r), nil
 }
 
-func stringConvert[T Convertable](x string) T {
-	return (interface{})(x).(T)
+func typeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
 }
 
-func intConvert[T Convertable](x string) T {
-	r, err := strconv.Atoi(x)
-	if err != nil {
-		panic(err)
+// Register adds a parse function used to convert strings into T, replacing any existing converter for T
+func Register[T any](parse func(string) (T, error)) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	registry[typeOf[T]()] = func(x string) T {
+		val, err := parse(x)
+		if err != nil {
+			panic(err)
+		}
+		return val
 	}
-	return (interface{})(r).(T)
 }
 
-func bitFieldConvert[T Convertable](x string) T {
-	return (interface{})(bits.NewBitField(x)).(T)
+// textUnmarshalerConvert creates a converter for types implementing encoding.TextUnmarshaler, either on a
+// pointer to the type or, for pointer types, on the type itself
+func textUnmarshalerConvert[T any]() (func(string) T, bool) {
+	unmarshal := func(target interface{}, x string) {
+		if err := target.(encoding.TextUnmarshaler).UnmarshalText([]byte(x)); err != nil {
+			panic(err)
+		}
+	}
+
+	if _, ok := (interface{})(new(T)).(encoding.TextUnmarshaler); ok {
+		return func(x string) T {
+			var val T
+			unmarshal(&val, x)
+			return val
+		}, true
+	}
+
+	// A nil pointer can't be unmarshalled into, so allocate the value it points to first
+	t := typeOf[T]()
+	if t.Kind() == reflect.Pointer && t.Implements(reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()) {
+		return func(x string) T {
+			val := reflect.New(t.Elem()).Interface().(T)
+			unmarshal(val, x)
+			return val
+		}, true
+	}
+	return nil, false
 }
 
+// FuncFor returns the converter for T. Registered parse functions take priority over encoding.TextUnmarshaler
 func FuncFor[T Convertable]() func(string) T {
-	val := *new(T)
-	switch (interface{})(val).(type) {
-	case string:
-		return stringConvert[T]
-	case int:
-		return intConvert[T]
-	case bits.BitField:
-		return bitFieldConvert[T]
-	default:
-		panic("unsupported converter")
+	registryLock.RLock()
+	converter, ok := registry[typeOf[T]()]
+	registryLock.RUnlock()
+	if ok {
+		return converter.(func(string) T)
+	}
+
+	if converter, ok := textUnmarshalerConvert[T](); ok {
+		// Cache the converter so later requests skip the interface check, without replacing a parse
+		// function registered in the meantime
+		registryLock.Lock()
+		if existing, ok := registry[typeOf[T]()]; ok {
+			converter = existing.(func(string) T)
+		} else {
+			registry[typeOf[T]()] = converter
+		}
+		registryLock.Unlock()
+		return converter
 	}
+	panic(fmt.Sprintf("unsupported converter for %v", typeOf[T]()))
 }
 
+// Apply converts a single string into T. The converter is looked up on every call, so resolve it once
+// with FuncFor when converting in a loop
 func Apply[T Convertable](in string) T {
 	return FuncFor[T]()(in)
 }
@@ -0,0 +1,80 @@
+package convert
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+// level implements encoding.TextUnmarshaler on its pointer, the usual pattern for value types
+type level int
+
+func (l *level) UnmarshalText(text []byte) error {
+	*l = level(strings.Count(string(text), "*"))
+	return nil
+}
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name string
+		got  func() interface{}
+		want string
+	}{
+		{"int", func() interface{} { return Apply[int]("-12") }, "-12"},
+		{"int64", func() interface{} { return Apply[int64]("9000000000") }, "9000000000"},
+		{"int32 is a number", func() interface{} { return Apply[int32]("7") }, "7"},
+		{"multi digit int32", func() interface{} { return Apply[int32]("12") }, "12"},
+		{"uint64", func() interface{} { return Apply[uint64]("18446744073709551615") }, "18446744073709551615"},
+		{"float64", func() interface{} { return Apply[float64]("1.5") }, "1.5"},
+		{"bool", func() interface{} { return Apply[bool]("true") }, "true"},
+		{"string", func() interface{} { return Apply[string]("abc") }, "abc"},
+		{"char", func() interface{} { return string(Apply[Char]("é")) }, "é"},
+		{"text unmarshaler on pointer", func() interface{} { return Apply[level]("***") }, "3"},
+		{"pointer text unmarshaler", func() interface{} { return Apply[*big.Int]("123456789012345678901234567890") }, "123456789012345678901234567890"},
+	}
+	for _, tc := range tests {
+		if got := fmt.Sprint(tc.got()); got != tc.want {
+			t.Errorf("%s: got %s, want %s", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestApplyPanics(t *testing.T) {
+	tests := []struct {
+		name  string
+		apply func()
+	}{
+		{"invalid int", func() { Apply[int]("x") }},
+		{"int32 overflow", func() { Apply[int32]("3000000000") }},
+		{"several chars", func() { Apply[Char]("ab") }},
+		{"invalid big int", func() { Apply[*big.Int]("12a") }},
+		{"unsupported type", func() { Apply[struct{}]("x") }},
+	}
+	for _, tc := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expected a panic", tc.name)
+				}
+			}()
+			tc.apply()
+		}()
+	}
+}
+
+func TestPointerConvertersAreIndependent(t *testing.T) {
+	convert := FuncFor[*big.Int]()
+	a, b := convert("1"), convert("2")
+	if a == b || a.Int64() != 1 || b.Int64() != 2 {
+		t.Errorf("got %v and %v, want separate values 1 and 2", a, b)
+	}
+}
+
+func TestRegisterTakesPriority(t *testing.T) {
+	type upper string
+	Register(func(x string) (upper, error) { return upper(strings.ToUpper(x)), nil })
+	if got := Apply[upper]("abc"); got != "ABC" {
+		t.Errorf("got %s, want ABC", got)
+	}
+}
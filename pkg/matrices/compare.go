@@ -0,0 +1,125 @@
+package matrices
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"math"
+)
+
+// Clone creates a deep copy of the matrix that can be modified independently
+func (m Matrix[T]) Clone() Matrix[T] {
+	data := make([][]T, m.Rows)
+	for y, row := range m.data {
+		data[y] = make([]T, m.Columns)
+		copy(data[y], row)
+	}
+	return NewMatrixFromData(data).WithWrap(m.wrap)
+}
+
+// Equal returns true if both matrices have the same dimensions and elements
+func Equal[T comparable](a, b Matrix[T]) bool {
+	if a.Rows != b.Rows || a.Columns != b.Columns {
+		return false
+	}
+	for y, row := range a.data {
+		for x, val := range row {
+			if b.data[y][x] != val {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Change describes an element that differs between two matrices
+type Change[T any] struct {
+	Point
+	Before, After T
+}
+
+// Diff returns every element that differs between the matrices, row by row. Both matrices must have the
+// same dimensions
+func Diff[T comparable](before, after Matrix[T]) []Change[T] {
+	if before.Rows != after.Rows || before.Columns != after.Columns {
+		panic(fmt.Sprintf("mismatching matrix dimensions %dx%d and %dx%d", before.Columns, before.Rows, after.Columns, after.Rows))
+	}
+	changes := []Change[T]{}
+	before.ForEach(func(x, y int, value T) {
+		if updated := after.data[y][x]; updated != value {
+			changes = append(changes, Change[T]{Point: Point{x, y}, Before: value, After: updated})
+		}
+	})
+	return changes
+}
+
+// Hash generates a hash of the dimensions and elements of the matrix, which can be used as a map key to
+// deduplicate states. For built in integer, float, bool and string elements the hash is stable between runs and
+// matrices with equal elements always have equal hashes. Other element types are hashed from their %#v
+// formatting, so elements containing pointers only hash consistently within a single run, and elements
+// containing floats may hash 0 and -0 differently
+func Hash[T comparable](m Matrix[T]) uint64 {
+	h := fnv.New64a()
+	writeHashValue(h, m.Rows)
+	writeHashValue(h, m.Columns)
+	m.ForEach(func(x, y int, value T) {
+		writeHashValue(h, value)
+	})
+	return h.Sum64()
+}
+
+// writeHashValue writes an unambiguous encoding of the value to the hash, with fast paths for common types
+func writeHashValue(h hash.Hash64, value interface{}) {
+	var buf [binary.MaxVarintLen64]byte
+	switch v := value.(type) {
+	case int:
+		h.Write(buf[:binary.PutVarint(buf[:], int64(v))])
+	case int64:
+		h.Write(buf[:binary.PutVarint(buf[:], v)])
+	case int32:
+		h.Write(buf[:binary.PutVarint(buf[:], int64(v))])
+	case uint64:
+		h.Write(buf[:binary.PutUvarint(buf[:], v)])
+	case int16:
+		h.Write(buf[:binary.PutVarint(buf[:], int64(v))])
+	case int8:
+		h.Write(buf[:binary.PutVarint(buf[:], int64(v))])
+	case uint:
+		h.Write(buf[:binary.PutUvarint(buf[:], uint64(v))])
+	case uint32:
+		h.Write(buf[:binary.PutUvarint(buf[:], uint64(v))])
+	case uint16:
+		h.Write(buf[:binary.PutUvarint(buf[:], uint64(v))])
+	case uint8:
+		h.Write([]byte{v})
+	case float64:
+		writeHashFloat(h, v)
+	case float32:
+		writeHashFloat(h, float64(v))
+	case bool:
+		if v {
+			h.Write([]byte{1})
+		} else {
+			h.Write([]byte{0})
+		}
+	case string:
+		h.Write(buf[:binary.PutUvarint(buf[:], uint64(len(v)))])
+		h.Write([]byte(v))
+	default:
+		// Prefix with the length so consecutive values can't run together
+		str := fmt.Sprintf("%#v", v)
+		h.Write(buf[:binary.PutUvarint(buf[:], uint64(len(str)))])
+		h.Write([]byte(str))
+	}
+}
+
+// writeHashFloat writes the bits of the float to the hash, treating -0 as 0 as they compare equal
+func writeHashFloat(h hash.Hash64, v float64) {
+	if v == 0 {
+		v = 0
+	}
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], math.Float64bits(v))
+	h.Write(buf[:])
+}
@@ -0,0 +1,70 @@
+package matrices
+
+// Components describes the connected regions of a matrix
+type Components struct {
+	// Labels holds the component each element belongs to, or -1 if it is not passable
+	Labels IntMatrix[int]
+	// Sizes holds the number of elements in each component, indexed by label
+	Sizes []int
+	// Cells holds the locations of the elements in each component, indexed by label
+	Cells [][]Point
+}
+
+// floodFrom visits every passable location connected to the origin (including the origin), using a queue
+// rather than recursion so large regions can't exhaust the stack. Visited locations are recorded in seen
+func (m Matrix[T]) floodFrom(originX, originY int, n Neighbourhood, passable func(x, y int, value T) bool, seen Matrix[bool], visit func(x, y int)) {
+	if seen.Get(originX, originY) || !passable(originX, originY, m.Get(originX, originY)) {
+		return
+	}
+	seen.Set(originX, originY, true)
+	queue := []Point{{originX, originY}}
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+		visit(p.X, p.Y)
+
+		m.ForEachNeighbour(n, p.X, p.Y, func(x, y int) {
+			if !seen.Get(x, y) && passable(x, y, m.Get(x, y)) {
+				seen.Set(x, y, true)
+				queue = append(queue, Point{x, y})
+			}
+		})
+	}
+}
+
+// FloodFill returns the locations of every passable element connected to the origin, in the order they
+// are reached. No locations are returned if the origin isn't passable
+func (m Matrix[T]) FloodFill(originX, originY int, n Neighbourhood, passable func(x, y int, value T) bool) []Point {
+	seen := NewMatrix[bool](m.Rows, m.Columns)
+	result := []Point{}
+	m.floodFrom(originX, originY, n, passable, seen, func(x, y int) {
+		result = append(result, Point{x, y})
+	})
+	return result
+}
+
+// LabelComponents groups every passable element into connected components. Components are labelled
+// in the order they are first found scanning row by row
+func (m Matrix[T]) LabelComponents(n Neighbourhood, passable func(x, y int, value T) bool) Components {
+	seen := NewMatrix[bool](m.Rows, m.Columns)
+	labels := NewIntMatrixFromBase(NewMatrix[int](m.Rows, m.Columns))
+	result := Components{Labels: labels}
+
+	m.ForEach(func(originX, originY int, value T) {
+		labels.Set(originX, originY, -1)
+	})
+	m.ForEach(func(originX, originY int, value T) {
+		if seen.Get(originX, originY) || !passable(originX, originY, value) {
+			return
+		}
+		label := len(result.Sizes)
+		cells := []Point{}
+		m.floodFrom(originX, originY, n, passable, seen, func(x, y int) {
+			labels.Set(x, y, label)
+			cells = append(cells, Point{x, y})
+		})
+		result.Sizes = append(result.Sizes, len(cells))
+		result.Cells = append(result.Cells, cells)
+	})
+	return result
+}
@@ -3,6 +3,7 @@ package matrices
 import (
 	"constraints"
 	"fmt"
+	"strings"
 )
 
 type IntMatrix[T constraints.Integer] struct {
@@ -40,3 +41,126 @@ func (m IntMatrix[T]) CompactString() string {
 	}
 	return out
 }
+
+// AlignedString produces a representation of the matrix with entries separated by spaces and right aligned
+// to the width of the widest entry, supporting multi digit and negative entries
+func (m IntMatrix[T]) AlignedString() string {
+	width := 0
+	m.ForEach(func(x, y int, value T) {
+		if w := len(fmt.Sprintf("%d", value)); w > width {
+			width = w
+		}
+	})
+
+	var out strings.Builder
+	for _, line := range m.data {
+		for i, val := range line {
+			if i > 0 {
+				out.WriteString(" ")
+			}
+			out.WriteString(fmt.Sprintf("%*d", width, val))
+		}
+		out.WriteString("\n")
+	}
+	return out.String()
+}
+
+// combine creates a new matrix by applying the operation to the elements at the same location in both matrices
+func (m IntMatrix[T]) combine(other IntMatrix[T], op func(a, b T) T) IntMatrix[T] {
+	if m.Rows != other.Rows || m.Columns != other.Columns {
+		panic(fmt.Sprintf("mismatching matrix dimensions %dx%d and %dx%d", m.Columns, m.Rows, other.Columns, other.Rows))
+	}
+	result := NewIntMatrixFromBase(NewMatrix[T](m.Rows, m.Columns).WithWrap(m.wrap))
+	m.ForEach(func(x, y int, value T) {
+		result.Set(x, y, op(value, other.Get(x, y)))
+	})
+	return result
+}
+
+// Add creates a new matrix with the elements of both matrices added together
+func (m IntMatrix[T]) Add(other IntMatrix[T]) IntMatrix[T] {
+	return m.combine(other, func(a, b T) T { return a + b })
+}
+
+// Sub creates a new matrix with the elements of the other matrix subtracted from this matrix
+func (m IntMatrix[T]) Sub(other IntMatrix[T]) IntMatrix[T] {
+	return m.combine(other, func(a, b T) T { return a - b })
+}
+
+// Scale creates a new matrix with every element multiplied by the factor
+func (m IntMatrix[T]) Scale(factor T) IntMatrix[T] {
+	result := NewIntMatrixFromBase(NewMatrix[T](m.Rows, m.Columns).WithWrap(m.wrap))
+	m.ForEach(func(x, y int, value T) {
+		result.Set(x, y, value*factor)
+	})
+	return result
+}
+
+// Multiply performs matrix multiplication, the number of columns must match the number of rows of the other matrix
+func (m IntMatrix[T]) Multiply(other IntMatrix[T]) IntMatrix[T] {
+	if m.Columns != other.Rows {
+		panic(fmt.Sprintf("unable to multiply %dx%d matrix by %dx%d matrix", m.Columns, m.Rows, other.Columns, other.Rows))
+	}
+	result := NewIntMatrixFromBase(NewMatrix[T](m.Rows, other.Columns))
+	result.ForEach(func(x, y int, _ T) {
+		var total T
+		for i := 0; i < m.Columns; i++ {
+			total += m.Get(i, y) * other.Get(x, i)
+		}
+		result.Set(x, y, total)
+	})
+	return result
+}
+
+// Sum will sum all values in the matrix
+func (m IntMatrix[T]) Sum() T {
+	var total T
+	m.ForEach(func(x, y int, value T) {
+		total += value
+	})
+	return total
+}
+
+// extreme finds the first element (scanning row by row) that is preferred over all others
+func (m IntMatrix[T]) extreme(prefer func(a, b T) bool) (T, Point) {
+	if m.Size == 0 {
+		panic("No entries in matrix")
+	}
+	best, loc := m.Get(0, 0), Point{}
+	m.ForEach(func(x, y int, value T) {
+		if prefer(value, best) {
+			best, loc = value, Point{x, y}
+		}
+	})
+	return best, loc
+}
+
+// Min returns the smallest value in the matrix and the location it first occurs
+func (m IntMatrix[T]) Min() (T, Point) {
+	return m.extreme(func(a, b T) bool { return a < b })
+}
+
+// Max returns the largest value in the matrix and the location it first occurs
+func (m IntMatrix[T]) Max() (T, Point) {
+	return m.extreme(func(a, b T) bool { return a > b })
+}
+
+// Count counts the number of elements that match the predicate
+func (m IntMatrix[T]) Count(predicate func(value T) bool) int {
+	count := 0
+	m.ForEach(func(x, y int, value T) {
+		if predicate(value) {
+			count++
+		}
+	})
+	return count
+}
+
+// Histogram counts how many times each value occurs in the matrix
+func (m IntMatrix[T]) Histogram() map[T]int {
+	result := make(map[T]int)
+	m.ForEach(func(x, y int, value T) {
+		result[value]++
+	})
+	return result
+}
@@ -0,0 +1,113 @@
+package matrices
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// BoundaryMode determines how locations outside of the matrix are resolved
+type BoundaryMode int
+
+const (
+	// BoundaryConstant uses a fixed value for every location outside of the matrix
+	BoundaryConstant BoundaryMode = iota
+	// BoundaryClamp uses the value of the nearest element on the edge of the matrix
+	BoundaryClamp
+	// BoundaryWrap wraps the location around to the opposite edge of the matrix
+	BoundaryWrap
+)
+
+// Boundary describes the value of locations outside of the matrix
+type Boundary[T any] struct {
+	Mode     BoundaryMode
+	Constant T
+}
+
+// ConstantBoundary resolves every location outside of the matrix to the value
+func ConstantBoundary[T any](val T) Boundary[T] {
+	return Boundary[T]{Mode: BoundaryConstant, Constant: val}
+}
+
+// ClampBoundary resolves locations outside of the matrix to the nearest edge element
+func ClampBoundary[T any]() Boundary[T] {
+	return Boundary[T]{Mode: BoundaryClamp}
+}
+
+// WrapBoundary resolves locations outside of the matrix by wrapping around to the opposite edge
+func WrapBoundary[T any]() Boundary[T] {
+	return Boundary[T]{Mode: BoundaryWrap}
+}
+
+// getBounded returns the element at the location, resolving locations outside of the matrix using the boundary
+func (m Matrix[T]) getBounded(x, y int, b Boundary[T]) T {
+	if !m.OutOfBounds(x, y) {
+		return m.data[y][x]
+	}
+	switch b.Mode {
+	case BoundaryClamp:
+		return m.data[clampIndex(y, m.Rows)][clampIndex(x, m.Columns)]
+	case BoundaryWrap:
+		return m.data[wrapIndex(y, m.Rows)][wrapIndex(x, m.Columns)]
+	default:
+		return b.Constant
+	}
+}
+
+func clampIndex(i, size int) int {
+	if i < 0 {
+		return 0
+	}
+	if i >= size {
+		return size - 1
+	}
+	return i
+}
+
+func validateKernelSize(size int) {
+	if size <= 0 || size%2 == 0 {
+		panic(fmt.Sprintf("kernel size must be odd, got %d", size))
+	}
+}
+
+// ApplyKernel creates a new matrix where each element is generated from the size by size neighbourhood centred
+// on the same location of the source matrix. The window is reused between elements so must not be retained
+func ApplyKernel[T, U any](m Matrix[T], size int, boundary Boundary[T], op func(x, y int, window Matrix[T]) U) Matrix[U] {
+	validateKernelSize(size)
+	radius := size / 2
+	window := NewMatrix[T](size, size)
+	result := NewMatrix[U](m.Rows, m.Columns).WithWrap(m.wrap)
+	m.ForEach(func(x, y int, value T) {
+		for j := 0; j < size; j++ {
+			for i := 0; i < size; i++ {
+				window.data[j][i] = m.getBounded(x+i-radius, y+j-radius, boundary)
+			}
+		}
+		result.data[y][x] = op(x, y, window)
+	})
+	return result
+}
+
+// ApplyBoolKernel creates a new matrix where each element is generated from the size by size neighbourhood of a
+// boolean matrix. The neighbourhood is encoded as an integer read row by row, with the top left element as the
+// most significant bit, avoiding building a window for each element
+func ApplyBoolKernel[U any](m Matrix[bool], size int, boundary Boundary[bool], op func(code int) U) Matrix[U] {
+	validateKernelSize(size)
+	if size*size >= strconv.IntSize {
+		panic(fmt.Sprintf("kernel size %d is too large to encode as an integer", size))
+	}
+	radius := size / 2
+	result := NewMatrix[U](m.Rows, m.Columns).WithWrap(m.wrap)
+	m.ForEach(func(x, y int, value bool) {
+		code := 0
+		for j := y - radius; j <= y+radius; j++ {
+			for i := x - radius; i <= x+radius; i++ {
+				code <<= 1
+				if m.getBounded(i, j, boundary) {
+					code |= 1
+				}
+			}
+		}
+		result.data[y][x] = op(code)
+	})
+	return result
+}
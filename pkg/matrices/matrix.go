@@ -4,6 +4,7 @@ type Matrix[T any] struct {
 	data          [][]T
 	Rows, Columns int
 	Size          int
+	wrap          bool
 }
 
 // NewMatrix creates a default matrix with provided dimensions
@@ -20,7 +21,10 @@ func NewMatrix[T any](rows, columns int) Matrix[T] {
 // the raw part into the required type
 func NewMatrixFromData[T any](data [][]T) Matrix[T] {
 	rows := len(data)
-	columns := len(data[0])
+	columns := 0
+	if rows > 0 {
+		columns = len(data[0])
+	}
 
 	for _, row := range data {
 		if len(row) != columns {
@@ -50,6 +54,15 @@ func (m *Matrix[T]) Get(x, y int) T {
 	return m.data[y][x]
 }
 
+// TryGet will return the provided element of the matrix, ok is false if the location is out of bounds
+func (m Matrix[T]) TryGet(x, y int) (T, bool) {
+	if m.OutOfBounds(x, y) {
+		var blank T
+		return blank, false
+	}
+	return m.data[y][x], true
+}
+
 // Set will set an element of the matrix
 func (m Matrix[T]) Set(x, y int, val T) {
 	m.data[y][x] = val
@@ -60,24 +73,101 @@ func (m Matrix[T]) OutOfBounds(x, y int) bool {
 	return x < 0 || x > m.Columns-1 || y < 0 || y > m.Rows-1
 }
 
-// ForEachNeighbour performs the operation on itself and its closest neighbours
-func (m Matrix[T]) ForEachNeighbour(includeDiags bool, originX, originY int, op func(x, y int)) {
-	opIfNotOutOfBounds := func(i, j int) {
-		if !m.OutOfBounds(i, j) {
-			op(i, j)
+// WithWrap returns the matrix (sharing the same data) where neighbours and offsets either wrap around the
+// edges of the matrix, treating it as a torus, or stop at the edges
+func (m Matrix[T]) WithWrap(wrap bool) Matrix[T] {
+	m.wrap = wrap
+	return m
+}
+
+// Wraps indicates if neighbours and offsets wrap around the edges of the matrix
+func (m Matrix[T]) Wraps() bool {
+	return m.wrap
+}
+
+// Offset returns the location offset from the origin. If the matrix wraps the location is wrapped
+// around the edges, otherwise ok is false when the location is out of bounds
+func (m Matrix[T]) Offset(originX, originY, dx, dy int) (x, y int, ok bool) {
+	x, y = originX+dx, originY+dy
+	if m.wrap {
+		if m.Size == 0 {
+			return x, y, false
 		}
+		return wrapIndex(x, m.Columns), wrapIndex(y, m.Rows), true
 	}
-	opIfNotOutOfBounds(originX, originY+1)
-	opIfNotOutOfBounds(originX, originY-1)
-	opIfNotOutOfBounds(originX-1, originY)
-	opIfNotOutOfBounds(originX+1, originY)
-
-	if includeDiags {
-		opIfNotOutOfBounds(originX+1, originY+1)
-		opIfNotOutOfBounds(originX+1, originY-1)
-		opIfNotOutOfBounds(originX-1, originY+1)
-		opIfNotOutOfBounds(originX-1, originY-1)
+	return x, y, !m.OutOfBounds(x, y)
+}
+
+// GetOffset returns the value at the location offset from the origin, see Offset
+func (m Matrix[T]) GetOffset(originX, originY, dx, dy int) (T, bool) {
+	x, y, ok := m.Offset(originX, originY, dx, dy)
+	if !ok {
+		var blank T
+		return blank, false
 	}
+	return m.data[y][x], true
+}
+
+func wrapIndex(i, size int) int {
+	return ((i % size) + size) % size
+}
+
+// ForEachNeighbour performs the operation on each location in the neighbourhood of the origin that
+// is within the matrix (or wrapped if the matrix wraps). When a wrapping matrix is smaller than the
+// neighbourhood, offsets that wrap back onto the origin are skipped and each location is only visited once
+func (m Matrix[T]) ForEachNeighbour(n Neighbourhood, originX, originY int, op func(x, y int)) {
+	if !m.wrap {
+		for _, offset := range n {
+			if x, y, ok := m.Offset(originX, originY, offset.X, offset.Y); ok {
+				op(x, y)
+			}
+		}
+		return
+	}
+
+	// Neighbourhoods are small, so a list is enough to track the visited locations
+	visited := make([]Point, 0, len(n)+1)
+	visited = append(visited, Point{originX, originY})
+	for _, offset := range n {
+		x, y, ok := m.Offset(originX, originY, offset.X, offset.Y)
+		if !ok || containsPoint(visited, Point{x, y}) {
+			continue
+		}
+		visited = append(visited, Point{x, y})
+		op(x, y)
+	}
+}
+
+func containsPoint(points []Point, p Point) bool {
+	for _, other := range points {
+		if other == p {
+			return true
+		}
+	}
+	return false
+}
+
+// Neighbours returns each location in the neighbourhood of the origin that is within the matrix
+// (or wrapped if the matrix wraps)
+func (m Matrix[T]) Neighbours(n Neighbourhood, originX, originY int) []Point {
+	result := make([]Point, 0, len(n))
+	m.ForEachNeighbour(n, originX, originY, func(x, y int) {
+		result = append(result, Point{x, y})
+	})
+	return result
+}
+
+// Shift creates a new matrix with every element moved by the offset. If the matrix wraps, elements
+// moved past an edge reappear on the opposite edge, otherwise they are lost and default values are
+// moved in
+func (m Matrix[T]) Shift(dx, dy int) Matrix[T] {
+	result := NewMatrix[T](m.Rows, m.Columns).WithWrap(m.wrap)
+	m.ForEach(func(x, y int, value T) {
+		if i, j, ok := m.Offset(x, y, dx, dy); ok {
+			result.Set(i, j, value)
+		}
+	})
+	return result
 }
 
 // Expand will increase the size the matrix. The value of these new entries are provided
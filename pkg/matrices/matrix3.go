@@ -0,0 +1,90 @@
+package matrices
+
+// Matrix3 is a dense 3D grid of elements
+type Matrix3[T any] struct {
+	data                  []T
+	Columns, Rows, Layers int
+	Size                  int
+}
+
+// NewMatrix3 creates a default 3D matrix with provided dimensions
+func NewMatrix3[T any](layers, rows, columns int) Matrix3[T] {
+	return Matrix3[T]{
+		data:    make([]T, layers*rows*columns),
+		Columns: columns,
+		Rows:    rows,
+		Layers:  layers,
+		Size:    layers * rows * columns,
+	}
+}
+
+func (m Matrix3[T]) index(x, y, z int) int {
+	return (z*m.Rows+y)*m.Columns + x
+}
+
+// Get will return the provided element of the matrix
+func (m Matrix3[T]) Get(x, y, z int) T {
+	return m.data[m.index(x, y, z)]
+}
+
+// Set will set an element of the matrix
+func (m Matrix3[T]) Set(x, y, z int, val T) {
+	m.data[m.index(x, y, z)] = val
+}
+
+// OutOfBounds indicates if the provided location exists in the matrix
+func (m Matrix3[T]) OutOfBounds(x, y, z int) bool {
+	return x < 0 || x > m.Columns-1 || y < 0 || y > m.Rows-1 || z < 0 || z > m.Layers-1
+}
+
+// ForEach performs the operation on every element in the matrix,
+// referencing the location and value of the element
+func (m Matrix3[T]) ForEach(op func(x, y, z int, value T)) {
+	i := 0
+	for z := 0; z < m.Layers; z++ {
+		for y := 0; y < m.Rows; y++ {
+			for x := 0; x < m.Columns; x++ {
+				op(x, y, z, m.data[i])
+				i++
+			}
+		}
+	}
+}
+
+// ForEachNeighbour performs the operation on the 6 face neighbours, or all 26 neighbours if diagonals
+// are included, that are within the matrix
+func (m Matrix3[T]) ForEachNeighbour(includeDiags bool, originX, originY, originZ int, op func(x, y, z int)) {
+	for _, offset := range offsets3(includeDiags) {
+		x, y, z := originX+offset.X, originY+offset.Y, originZ+offset.Z
+		if !m.OutOfBounds(x, y, z) {
+			op(x, y, z)
+		}
+	}
+}
+
+// PlaneXY creates a matrix of the layer at the provided z, with x as columns and y as rows
+func (m Matrix3[T]) PlaneXY(z int) Matrix[T] {
+	plane := NewMatrix[T](m.Rows, m.Columns)
+	plane.ForEach(func(x, y int, value T) {
+		plane.Set(x, y, m.Get(x, y, z))
+	})
+	return plane
+}
+
+// PlaneXZ creates a matrix of the slice at the provided y, with x as columns and z as rows
+func (m Matrix3[T]) PlaneXZ(y int) Matrix[T] {
+	plane := NewMatrix[T](m.Layers, m.Columns)
+	plane.ForEach(func(x, z int, value T) {
+		plane.Set(x, z, m.Get(x, y, z))
+	})
+	return plane
+}
+
+// PlaneYZ creates a matrix of the slice at the provided x, with y as columns and z as rows
+func (m Matrix3[T]) PlaneYZ(x int) Matrix[T] {
+	plane := NewMatrix[T](m.Layers, m.Rows)
+	plane.ForEach(func(y, z int, value T) {
+		plane.Set(y, z, m.Get(x, y, z))
+	})
+	return plane
+}
@@ -0,0 +1,33 @@
+package matrices
+
+// Neighbourhood is a list of offsets from an origin that are considered its neighbours
+type Neighbourhood []Point
+
+// VonNeumann creates a neighbourhood of every location within the manhattan distance of the origin
+func VonNeumann(radius int) Neighbourhood {
+	return neighbourhoodWithin(radius, func(dx, dy int) int { return abs(dx) + abs(dy) })
+}
+
+// Moore creates a neighbourhood of every location within the square of the provided radius around the origin
+func Moore(radius int) Neighbourhood {
+	return neighbourhoodWithin(radius, func(dx, dy int) int {
+		if abs(dx) > abs(dy) {
+			return abs(dx)
+		}
+		return abs(dy)
+	})
+}
+
+// neighbourhoodWithin creates a neighbourhood of every offset (excluding the origin) where the distance
+// is within the radius, ordered row by row
+func neighbourhoodWithin(radius int, distance func(dx, dy int) int) Neighbourhood {
+	n := Neighbourhood{}
+	for dy := -radius; dy <= radius; dy++ {
+		for dx := -radius; dx <= radius; dx++ {
+			if d := distance(dx, dy); d != 0 && d <= radius {
+				n = append(n, Point{dx, dy})
+			}
+		}
+	}
+	return n
+}
@@ -0,0 +1,36 @@
+package matrices
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWrappedNeighbours(t *testing.T) {
+	tests := []struct {
+		name          string
+		rows, columns int
+		n             Neighbourhood
+		x, y          int
+		want          []Point
+	}{
+		{"single cell has no neighbours", 1, 1, Moore(1), 0, 0, []Point{}},
+		{"single row", 1, 2, Moore(1), 0, 0, []Point{{1, 0}}},
+		{"two by two", 2, 2, Moore(1), 0, 0, []Point{{1, 1}, {0, 1}, {1, 0}}},
+		{"radius wider than matrix", 1, 3, VonNeumann(2), 1, 0, []Point{{0, 0}, {2, 0}}},
+		{"large enough keeps every offset", 3, 3, VonNeumann(1), 0, 0, []Point{{0, 2}, {2, 0}, {1, 0}, {0, 1}}},
+	}
+	for _, tc := range tests {
+		m := NewMatrix[int](tc.rows, tc.columns).WithWrap(true)
+		if got := m.Neighbours(tc.n, tc.x, tc.y); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: got %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestBoundedNeighbours(t *testing.T) {
+	m := NewMatrix[int](2, 2)
+	want := []Point{{1, 0}, {0, 1}, {1, 1}}
+	if got := m.Neighbours(Moore(1), 0, 0); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
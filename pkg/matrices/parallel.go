@@ -0,0 +1,64 @@
+package matrices
+
+import (
+	"runtime"
+	"sync"
+)
+
+// forEachRowRange splits the rows into contiguous ranges and processes each range in its own goroutine,
+// waiting for all of them to finish. A worker count of 0 or less uses one worker per available CPU
+func forEachRowRange(rows, workers int, op func(startRow, endRow int)) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > rows {
+		workers = rows
+	}
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		startRow, endRow := rows*w/workers, rows*(w+1)/workers
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			op(startRow, endRow)
+		}()
+	}
+	wg.Wait()
+}
+
+// ParallelForEach performs the operation on every element in the matrix, with the rows split between
+// workers. The operation is called concurrently so must not modify shared state without synchronisation
+func (m Matrix[T]) ParallelForEach(workers int, op func(x, y int, value T)) {
+	forEachRowRange(m.Rows, workers, func(startRow, endRow int) {
+		for j := startRow; j < endRow; j++ {
+			for i, value := range m.data[j] {
+				op(i, j, value)
+			}
+		}
+	})
+}
+
+// ParallelMap creates a new matrix where each element is generated from the element at the same location,
+// with the rows split between workers
+func ParallelMap[T, U any](m Matrix[T], workers int, op func(x, y int, value T) U) Matrix[U] {
+	result := NewMatrix[U](m.Rows, m.Columns).WithWrap(m.wrap)
+	ParallelMapInto(result, m, workers, op)
+	return result
+}
+
+// ParallelMapInto writes each element generated from the source matrix into the destination matrix, with the
+// rows split between workers. As each worker only writes its own rows of a separate destination, the
+// operation may freely read any element of the source
+func ParallelMapInto[T, U any](dst Matrix[U], src Matrix[T], workers int, op func(x, y int, value T) U) {
+	if dst.Rows != src.Rows || dst.Columns != src.Columns {
+		panic("unable to map into matrix, mismatching dimensions")
+	}
+	forEachRowRange(src.Rows, workers, func(startRow, endRow int) {
+		for j := startRow; j < endRow; j++ {
+			for i, value := range src.data[j] {
+				dst.data[j][i] = op(i, j, value)
+			}
+		}
+	})
+}
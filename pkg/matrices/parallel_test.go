@@ -0,0 +1,109 @@
+package matrices
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+)
+
+// benchmarkGrid creates a large grid with varied values so the work per element can't be optimised away
+func benchmarkGrid() Matrix[int] {
+	m := NewMatrix[int](1000, 1000)
+	m.ForEach(func(x, y int, _ int) {
+		m.Set(x, y, (x*31+y*17)%10)
+	})
+	return m
+}
+
+var surrounding = Moore(1)
+
+// neighbourSum adds up the surrounding elements, giving each element a realistic amount of work
+func neighbourSum(m Matrix[int], x, y int) int {
+	total := 0
+	m.ForEachNeighbour(surrounding, x, y, func(nx, ny int) {
+		total += m.Get(nx, ny)
+	})
+	return total
+}
+
+// workerCounts compares a single worker with one worker per CPU, when there is more than one
+func workerCounts() []int {
+	if procs := runtime.GOMAXPROCS(0); procs > 1 {
+		return []int{1, procs}
+	}
+	return []int{1}
+}
+
+func BenchmarkForEach(b *testing.B) {
+	m := benchmarkGrid()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		total := 0
+		m.ForEach(func(x, y int, _ int) {
+			total += neighbourSum(m, x, y)
+		})
+	}
+}
+
+func BenchmarkParallelForEach(b *testing.B) {
+	m := benchmarkGrid()
+	for _, workers := range workerCounts() {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				// Each element writes its own location, so no synchronisation is needed
+				sums := NewMatrix[int](m.Rows, m.Columns)
+				m.ParallelForEach(workers, func(x, y int, _ int) {
+					sums.Set(x, y, neighbourSum(m, x, y))
+				})
+			}
+		})
+	}
+}
+
+func BenchmarkMap(b *testing.B) {
+	m := benchmarkGrid()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		result := NewMatrix[int](m.Rows, m.Columns)
+		m.ForEach(func(x, y int, _ int) {
+			result.Set(x, y, neighbourSum(m, x, y))
+		})
+	}
+}
+
+func BenchmarkParallelMap(b *testing.B) {
+	m := benchmarkGrid()
+	for _, workers := range workerCounts() {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				ParallelMap(m, workers, func(x, y int, _ int) int {
+					return neighbourSum(m, x, y)
+				})
+			}
+		})
+	}
+}
+
+// TestParallelMapIntoReadsNeighbours checks workers can read source elements owned by other workers,
+// run with -race to confirm there are no data races
+func TestParallelMapIntoReadsNeighbours(t *testing.T) {
+	src := NewMatrix[int](50, 40)
+	src.ForEach(func(x, y int, _ int) {
+		src.Set(x, y, x*y+1)
+	})
+
+	expected := NewMatrix[int](src.Rows, src.Columns)
+	src.ForEach(func(x, y int, _ int) {
+		expected.Set(x, y, neighbourSum(src, x, y))
+	})
+
+	for _, workers := range []int{1, 3, 8, 0} {
+		dst := NewMatrix[int](src.Rows, src.Columns)
+		ParallelMapInto(dst, src, workers, func(x, y int, _ int) int {
+			return neighbourSum(src, x, y)
+		})
+		if diff := Diff(expected, dst); len(diff) != 0 {
+			t.Errorf("workers=%d: %d elements differ, first %+v", workers, len(diff), diff[0])
+		}
+	}
+}
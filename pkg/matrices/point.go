@@ -0,0 +1,99 @@
+package matrices
+
+// Point is a signed location on a 2D grid
+type Point struct{ X, Y int }
+
+// Add will offset the point by another point
+func (p Point) Add(other Point) Point {
+	return Point{p.X + other.X, p.Y + other.Y}
+}
+
+// Min returns the point with the smaller value along each axis
+func (p Point) Min(other Point) Point {
+	return Point{minInt(p.X, other.X), minInt(p.Y, other.Y)}
+}
+
+// Max returns the point with the larger value along each axis
+func (p Point) Max(other Point) Point {
+	return Point{maxInt(p.X, other.X), maxInt(p.Y, other.Y)}
+}
+
+// Point3 is a signed location on a 3D grid
+type Point3 struct{ X, Y, Z int }
+
+// Add will offset the point by another point
+func (p Point3) Add(other Point3) Point3 {
+	return Point3{p.X + other.X, p.Y + other.Y, p.Z + other.Z}
+}
+
+// Sub will return the offset from another point to this point
+func (p Point3) Sub(other Point3) Point3 {
+	return Point3{p.X - other.X, p.Y - other.Y, p.Z - other.Z}
+}
+
+// Manhattan returns the sum of the distances along each axis between the points
+func (p Point3) Manhattan(other Point3) int {
+	d := p.Sub(other)
+	return abs(d.X) + abs(d.Y) + abs(d.Z)
+}
+
+// Min returns the point with the smaller value along each axis
+func (p Point3) Min(other Point3) Point3 {
+	return Point3{minInt(p.X, other.X), minInt(p.Y, other.Y), minInt(p.Z, other.Z)}
+}
+
+// Max returns the point with the larger value along each axis
+func (p Point3) Max(other Point3) Point3 {
+	return Point3{maxInt(p.X, other.X), maxInt(p.Y, other.Y), maxInt(p.Z, other.Z)}
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// neighbourOffsets3 generates the offsets to the 6 face neighbours, or all 26 neighbours if diagonals
+// are included
+func neighbourOffsets3(includeDiags bool) []Point3 {
+	offsets := []Point3{}
+	for dz := -1; dz <= 1; dz++ {
+		for dy := -1; dy <= 1; dy++ {
+			for dx := -1; dx <= 1; dx++ {
+				distance := abs(dx) + abs(dy) + abs(dz)
+				if distance == 0 || (!includeDiags && distance > 1) {
+					continue
+				}
+				offsets = append(offsets, Point3{dx, dy, dz})
+			}
+		}
+	}
+	return offsets
+}
+
+var (
+	faceOffsets3 = neighbourOffsets3(false)
+	allOffsets3  = neighbourOffsets3(true)
+)
+
+func offsets3(includeDiags bool) []Point3 {
+	if includeDiags {
+		return allOffsets3
+	}
+	return faceOffsets3
+}
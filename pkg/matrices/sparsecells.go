@@ -0,0 +1,82 @@
+package matrices
+
+// boundedPoint is a location that can be combined with another along each axis, used to track bounds
+type boundedPoint[P any] interface {
+	comparable
+	Min(other P) P
+	Max(other P) P
+}
+
+// sparseCells stores the cells of a sparse grid that differ from the background, shared by the 2D and 3D
+// grids. The bounding box is kept up to date as cells are added, and only recalculated after removals
+type sparseCells[P boundedPoint[P], T comparable] struct {
+	cells      map[P]T
+	background T
+
+	min, max    P
+	boundsDirty bool
+}
+
+func newSparseCells[P boundedPoint[P], T comparable](background T) sparseCells[P, T] {
+	return sparseCells[P, T]{cells: make(map[P]T), background: background}
+}
+
+func (s *sparseCells[P, T]) get(p P) T {
+	if val, ok := s.cells[p]; ok {
+		return val
+	}
+	return s.background
+}
+
+func (s *sparseCells[P, T]) set(p P, val T) {
+	if val == s.background {
+		if _, ok := s.cells[p]; ok {
+			delete(s.cells, p)
+			s.boundsDirty = true
+		}
+		return
+	}
+
+	if !s.boundsDirty {
+		if len(s.cells) == 0 {
+			s.min, s.max = p, p
+		} else {
+			s.min, s.max = s.min.Min(p), s.max.Max(p)
+		}
+	}
+	s.cells[p] = val
+}
+
+func (s *sparseCells[P, T]) isSet(p P) bool {
+	_, ok := s.cells[p]
+	return ok
+}
+
+// setBackground changes the background, removing any cells that now match it
+func (s *sparseCells[P, T]) setBackground(val T) {
+	s.background = val
+	for p, v := range s.cells {
+		if v == val {
+			delete(s.cells, p)
+			s.boundsDirty = true
+		}
+	}
+}
+
+func (s *sparseCells[P, T]) bounds() (minPoint, maxPoint P, ok bool) {
+	if len(s.cells) == 0 {
+		return minPoint, maxPoint, false
+	}
+	if s.boundsDirty {
+		started := false
+		for p := range s.cells {
+			if !started {
+				s.min, s.max = p, p
+				started = true
+			}
+			s.min, s.max = s.min.Min(p), s.max.Max(p)
+		}
+		s.boundsDirty = false
+	}
+	return s.min, s.max, true
+}
@@ -0,0 +1,90 @@
+package matrices
+
+// SparseGrid is an unbounded grid where only cells that differ from the background value are stored
+type SparseGrid[T comparable] struct {
+	store sparseCells[Point, T]
+}
+
+// NewSparseGrid creates an empty grid where every cell has the background value
+func NewSparseGrid[T comparable](background T) *SparseGrid[T] {
+	return &SparseGrid[T]{store: newSparseCells[Point](background)}
+}
+
+// NewSparseGridFromMatrix creates a grid from the matrix, with the top left of the matrix at the origin
+func NewSparseGridFromMatrix[T comparable](m Matrix[T], background T) *SparseGrid[T] {
+	return NewSparseGridFromMatrixAt(m, Point{}, background)
+}
+
+// NewSparseGridFromMatrixAt creates a grid from the matrix, with the top left of the matrix at the provided location
+func NewSparseGridFromMatrixAt[T comparable](m Matrix[T], topLeft Point, background T) *SparseGrid[T] {
+	g := NewSparseGrid(background)
+	m.ForEach(func(x, y int, value T) {
+		g.Set(topLeft.X+x, topLeft.Y+y, value)
+	})
+	return g
+}
+
+// Background returns the value of every cell that has not been set
+func (g *SparseGrid[T]) Background() T {
+	return g.store.background
+}
+
+// SetBackground changes the value of every cell that has not been set, any cells matching the
+// new background are no longer stored
+func (g *SparseGrid[T]) SetBackground(val T) {
+	g.store.setBackground(val)
+}
+
+// Get will return the value at the location, or the background if it has not been set
+func (g *SparseGrid[T]) Get(x, y int) T {
+	return g.store.get(Point{x, y})
+}
+
+// Set will set the value at the location, setting the background value removes the cell
+func (g *SparseGrid[T]) Set(x, y int, val T) {
+	g.store.set(Point{x, y}, val)
+}
+
+// IsSet indicates if the location has a value other than the background
+func (g *SparseGrid[T]) IsSet(x, y int) bool {
+	return g.store.isSet(Point{x, y})
+}
+
+// Len returns the number of cells with a value other than the background
+func (g *SparseGrid[T]) Len() int {
+	return len(g.store.cells)
+}
+
+// Bounds returns the smallest box containing every set cell, ok is false if no cells are set
+func (g *SparseGrid[T]) Bounds() (minPoint, maxPoint Point, ok bool) {
+	return g.store.bounds()
+}
+
+// ForEach performs the operation on every set cell (undefined order)
+func (g *SparseGrid[T]) ForEach(op func(x, y int, value T)) {
+	for p, v := range g.store.cells {
+		op(p.X, p.Y, v)
+	}
+}
+
+// ToMatrix creates a matrix covering the bounds of the set cells, along with the location of the
+// top left cell of the matrix in the grid
+func (g *SparseGrid[T]) ToMatrix() (Matrix[T], Point) {
+	minPoint, maxPoint, ok := g.Bounds()
+	if !ok {
+		return NewMatrix[T](0, 0), Point{}
+	}
+	return g.ToMatrixWithin(minPoint, maxPoint), minPoint
+}
+
+// ToMatrixWithin creates a matrix of the provided region of the grid (inclusive), unset cells are
+// filled with the background
+func (g *SparseGrid[T]) ToMatrixWithin(minPoint, maxPoint Point) Matrix[T] {
+	m := NewMatrix[T](maxPoint.Y-minPoint.Y+1, maxPoint.X-minPoint.X+1)
+	for j := 0; j < m.Rows; j++ {
+		for i := 0; i < m.Columns; i++ {
+			m.Set(i, j, g.Get(minPoint.X+i, minPoint.Y+j))
+		}
+	}
+	return m
+}
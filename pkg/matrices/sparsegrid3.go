@@ -0,0 +1,126 @@
+package matrices
+
+// SparseGrid3 is an unbounded 3D grid where only cells that differ from the background value are stored
+type SparseGrid3[T comparable] struct {
+	store sparseCells[Point3, T]
+}
+
+// NewSparseGrid3 creates an empty grid where every cell has the background value
+func NewSparseGrid3[T comparable](background T) *SparseGrid3[T] {
+	return &SparseGrid3[T]{store: newSparseCells[Point3](background)}
+}
+
+// NewSparseGrid3FromMatrix3 creates a grid from the matrix, with the first element of the matrix at the origin
+func NewSparseGrid3FromMatrix3[T comparable](m Matrix3[T], background T) *SparseGrid3[T] {
+	return NewSparseGrid3FromMatrix3At(m, Point3{}, background)
+}
+
+// NewSparseGrid3FromMatrix3At creates a grid from the matrix, with the first element of the matrix at the
+// provided location
+func NewSparseGrid3FromMatrix3At[T comparable](m Matrix3[T], corner Point3, background T) *SparseGrid3[T] {
+	g := NewSparseGrid3(background)
+	m.ForEach(func(x, y, z int, value T) {
+		g.Set(corner.X+x, corner.Y+y, corner.Z+z, value)
+	})
+	return g
+}
+
+// Background returns the value of every cell that has not been set
+func (g *SparseGrid3[T]) Background() T {
+	return g.store.background
+}
+
+// SetBackground changes the value of every cell that has not been set, any cells matching the
+// new background are no longer stored
+func (g *SparseGrid3[T]) SetBackground(val T) {
+	g.store.setBackground(val)
+}
+
+// Get will return the value at the location, or the background if it has not been set
+func (g *SparseGrid3[T]) Get(x, y, z int) T {
+	return g.store.get(Point3{x, y, z})
+}
+
+// Set will set the value at the location, setting the background value removes the cell
+func (g *SparseGrid3[T]) Set(x, y, z int, val T) {
+	g.store.set(Point3{x, y, z}, val)
+}
+
+// IsSet indicates if the location has a value other than the background
+func (g *SparseGrid3[T]) IsSet(x, y, z int) bool {
+	return g.store.isSet(Point3{x, y, z})
+}
+
+// Len returns the number of cells with a value other than the background
+func (g *SparseGrid3[T]) Len() int {
+	return len(g.store.cells)
+}
+
+// Bounds returns the smallest box containing every set cell, ok is false if no cells are set
+func (g *SparseGrid3[T]) Bounds() (minPoint, maxPoint Point3, ok bool) {
+	return g.store.bounds()
+}
+
+// ForEach performs the operation on every set cell (undefined order)
+func (g *SparseGrid3[T]) ForEach(op func(x, y, z int, value T)) {
+	for p, v := range g.store.cells {
+		op(p.X, p.Y, p.Z, v)
+	}
+}
+
+// ForEachNeighbour performs the operation on the 6 face neighbours, or all 26 neighbours if diagonals
+// are included
+func (g *SparseGrid3[T]) ForEachNeighbour(includeDiags bool, originX, originY, originZ int, op func(x, y, z int)) {
+	for _, offset := range offsets3(includeDiags) {
+		op(originX+offset.X, originY+offset.Y, originZ+offset.Z)
+	}
+}
+
+// ToMatrix3 creates a matrix covering the bounds of the set cells, along with the location of the
+// first element of the matrix in the grid
+func (g *SparseGrid3[T]) ToMatrix3() (Matrix3[T], Point3) {
+	minPoint, maxPoint, ok := g.Bounds()
+	if !ok {
+		return NewMatrix3[T](0, 0, 0), Point3{}
+	}
+	return g.ToMatrix3Within(minPoint, maxPoint), minPoint
+}
+
+// ToMatrix3Within creates a matrix of the provided region of the grid (inclusive), unset cells are
+// filled with the background
+func (g *SparseGrid3[T]) ToMatrix3Within(minPoint, maxPoint Point3) Matrix3[T] {
+	m := NewMatrix3[T](maxPoint.Z-minPoint.Z+1, maxPoint.Y-minPoint.Y+1, maxPoint.X-minPoint.X+1)
+	for k := 0; k < m.Layers; k++ {
+		for j := 0; j < m.Rows; j++ {
+			for i := 0; i < m.Columns; i++ {
+				m.Set(i, j, k, g.Get(minPoint.X+i, minPoint.Y+j, minPoint.Z+k))
+			}
+		}
+	}
+	return m
+}
+
+// PlaneXY creates a 2D grid of the set cells in the layer at the provided z, with x and y kept
+func (g *SparseGrid3[T]) PlaneXY(z int) *SparseGrid[T] {
+	return g.plane(func(p Point3) (Point, bool) { return Point{p.X, p.Y}, p.Z == z })
+}
+
+// PlaneXZ creates a 2D grid of the set cells in the slice at the provided y, with x and z as the 2D x and y
+func (g *SparseGrid3[T]) PlaneXZ(y int) *SparseGrid[T] {
+	return g.plane(func(p Point3) (Point, bool) { return Point{p.X, p.Z}, p.Y == y })
+}
+
+// PlaneYZ creates a 2D grid of the set cells in the slice at the provided x, with y and z as the 2D x and y
+func (g *SparseGrid3[T]) PlaneYZ(x int) *SparseGrid[T] {
+	return g.plane(func(p Point3) (Point, bool) { return Point{p.Y, p.Z}, p.X == x })
+}
+
+func (g *SparseGrid3[T]) plane(project func(p Point3) (Point, bool)) *SparseGrid[T] {
+	result := NewSparseGrid(g.store.background)
+	for p, v := range g.store.cells {
+		if projected, ok := project(p); ok {
+			result.Set(projected.X, projected.Y, v)
+		}
+	}
+	return result
+}
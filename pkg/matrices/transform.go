@@ -0,0 +1,84 @@
+package matrices
+
+import "fmt"
+
+// Row returns a copy of the row at the provided location
+func (m Matrix[T]) Row(y int) []T {
+	row := make([]T, m.Columns)
+	copy(row, m.data[y])
+	return row
+}
+
+// Column returns a copy of the column at the provided location
+func (m Matrix[T]) Column(x int) []T {
+	column := make([]T, m.Rows)
+	for y := range column {
+		column[y] = m.data[y][x]
+	}
+	return column
+}
+
+// remap creates a new matrix with the provided dimensions, where each element is taken from the
+// location in this matrix returned by source
+func (m Matrix[T]) remap(rows, columns int, source func(x, y int) (int, int)) Matrix[T] {
+	result := NewMatrix[T](rows, columns).WithWrap(m.wrap)
+	for j := 0; j < rows; j++ {
+		for i := 0; i < columns; i++ {
+			sx, sy := source(i, j)
+			result.data[j][i] = m.data[sy][sx]
+		}
+	}
+	return result
+}
+
+// Transpose creates a new matrix with the rows and columns swapped
+func (m Matrix[T]) Transpose() Matrix[T] {
+	return m.remap(m.Columns, m.Rows, func(x, y int) (int, int) { return y, x })
+}
+
+// Rotate90 creates a new matrix rotated 90 degrees clockwise
+func (m Matrix[T]) Rotate90() Matrix[T] {
+	return m.remap(m.Columns, m.Rows, func(x, y int) (int, int) { return y, m.Rows - 1 - x })
+}
+
+// Rotate180 creates a new matrix rotated 180 degrees
+func (m Matrix[T]) Rotate180() Matrix[T] {
+	return m.remap(m.Rows, m.Columns, func(x, y int) (int, int) { return m.Columns - 1 - x, m.Rows - 1 - y })
+}
+
+// Rotate270 creates a new matrix rotated 270 degrees clockwise (90 degrees anticlockwise)
+func (m Matrix[T]) Rotate270() Matrix[T] {
+	return m.remap(m.Columns, m.Rows, func(x, y int) (int, int) { return m.Columns - 1 - y, x })
+}
+
+// FlipHorizontal creates a new matrix mirrored left to right
+func (m Matrix[T]) FlipHorizontal() Matrix[T] {
+	return m.remap(m.Rows, m.Columns, func(x, y int) (int, int) { return m.Columns - 1 - x, y })
+}
+
+// FlipVertical creates a new matrix mirrored top to bottom
+func (m Matrix[T]) FlipVertical() Matrix[T] {
+	return m.remap(m.Rows, m.Columns, func(x, y int) (int, int) { return x, m.Rows - 1 - y })
+}
+
+// SubMatrix creates a new matrix from the region starting at the provided location
+func (m Matrix[T]) SubMatrix(x, y, columns, rows int) Matrix[T] {
+	if x < 0 || y < 0 || columns < 0 || rows < 0 || x+columns > m.Columns || y+rows > m.Rows {
+		panic(fmt.Sprintf("sub matrix (%d,%d) %dx%d is outside of %dx%d matrix", x, y, columns, rows, m.Columns, m.Rows))
+	}
+	return m.remap(rows, columns, func(i, j int) (int, int) { return x + i, y + j })
+}
+
+// Tile creates a new matrix by repeating this matrix nx times across and ny times down. The value of
+// each element is generated from the tile location and the original value
+func (m Matrix[T]) Tile(nx, ny int, op func(tileX, tileY int, value T) T) Matrix[T] {
+	result := NewMatrix[T](m.Rows*ny, m.Columns*nx).WithWrap(m.wrap)
+	for tileY := 0; tileY < ny; tileY++ {
+		for tileX := 0; tileX < nx; tileX++ {
+			m.ForEach(func(x, y int, value T) {
+				result.data[tileY*m.Rows+y][tileX*m.Columns+x] = op(tileX, tileY, value)
+			})
+		}
+	}
+	return result
+}
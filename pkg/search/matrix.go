@@ -0,0 +1,63 @@
+package search
+
+import (
+	"adventofcode2021/pkg/matrices"
+	"adventofcode2021/pkg/slices"
+)
+
+// matrixEdges creates a neighbour function for the matrix, where the cost of moving is the cost of entering
+// the neighbouring element. Elements are impassable when the cost function returns false
+func matrixEdges[T any, C slices.Number](m matrices.Matrix[T], n matrices.Neighbourhood, cost func(x, y int, value T) (C, bool)) func(matrices.Point) []Edge[matrices.Point, C] {
+	return func(p matrices.Point) []Edge[matrices.Point, C] {
+		edges := []Edge[matrices.Point, C]{}
+		m.ForEachNeighbour(n, p.X, p.Y, func(x, y int) {
+			if c, ok := cost(x, y, m.Get(x, y)); ok {
+				edges = append(edges, Edge[matrices.Point, C]{To: matrices.Point{X: x, Y: y}, Cost: c})
+			}
+		})
+		return edges
+	}
+}
+
+// isPoint creates a goal function matching a single location
+func isPoint(goal matrices.Point) func(matrices.Point) bool {
+	return func(p matrices.Point) bool { return p == goal }
+}
+
+// Manhattan creates a heuristic estimating the distance to the goal, suitable for matrices without
+// diagonal moves where every move costs at least 1
+func Manhattan[C slices.Number](goal matrices.Point) func(matrices.Point) C {
+	return func(p matrices.Point) C {
+		dx, dy := p.X-goal.X, p.Y-goal.Y
+		if dx < 0 {
+			dx = -dx
+		}
+		if dy < 0 {
+			dy = -dy
+		}
+		return C(dx + dy)
+	}
+}
+
+// MatrixDijkstra finds the cheapest path between two locations of the matrix, see Dijkstra
+func MatrixDijkstra[T any, C slices.Number](m matrices.Matrix[T], start, goal matrices.Point, n matrices.Neighbourhood, cost func(x, y int, value T) (C, bool)) (C, []matrices.Point, bool) {
+	return Dijkstra(start, isPoint(goal), matrixEdges(m, n, cost))
+}
+
+// MatrixAStar finds the cheapest path between two locations of the matrix, see AStar
+func MatrixAStar[T any, C slices.Number](m matrices.Matrix[T], start, goal matrices.Point, n matrices.Neighbourhood, cost func(x, y int, value T) (C, bool), heuristic func(matrices.Point) C) (C, []matrices.Point, bool) {
+	return AStar(start, isPoint(goal), matrixEdges(m, n, cost), heuristic)
+}
+
+// MatrixBFS finds the path with the fewest steps between two locations of the matrix, see BFS
+func MatrixBFS[T any](m matrices.Matrix[T], start, goal matrices.Point, n matrices.Neighbourhood, passable func(x, y int, value T) bool) (int, []matrices.Point, bool) {
+	return BFS(start, isPoint(goal), func(p matrices.Point) []matrices.Point {
+		next := []matrices.Point{}
+		m.ForEachNeighbour(n, p.X, p.Y, func(x, y int) {
+			if passable(x, y, m.Get(x, y)) {
+				next = append(next, matrices.Point{X: x, Y: y})
+			}
+		})
+		return next
+	})
+}
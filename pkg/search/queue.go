@@ -0,0 +1,38 @@
+package search
+
+import (
+	"adventofcode2021/pkg/slices"
+	"container/heap"
+)
+
+type queueItem[N comparable, C slices.Number] struct {
+	node     N
+	cost     C
+	priority C
+}
+
+// priorityQueue is a min heap of nodes ordered by priority
+type priorityQueue[N comparable, C slices.Number] []queueItem[N, C]
+
+func (q priorityQueue[N, C]) Len() int           { return len(q) }
+func (q priorityQueue[N, C]) Less(i, j int) bool { return q[i].priority < q[j].priority }
+func (q priorityQueue[N, C]) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+
+func (q *priorityQueue[N, C]) Push(x interface{}) {
+	*q = append(*q, x.(queueItem[N, C]))
+}
+
+func (q *priorityQueue[N, C]) Pop() interface{} {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
+
+func (q *priorityQueue[N, C]) push(node N, cost, priority C) {
+	heap.Push(q, queueItem[N, C]{node: node, cost: cost, priority: priority})
+}
+
+func (q *priorityQueue[N, C]) pop() queueItem[N, C] {
+	return heap.Pop(q).(queueItem[N, C])
+}
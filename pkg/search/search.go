@@ -0,0 +1,91 @@
+package search
+
+import "adventofcode2021/pkg/slices"
+
+// Edge is a neighbouring node along with the cost to move to it
+type Edge[N comparable, C slices.Number] struct {
+	To   N
+	Cost C
+}
+
+// Dijkstra uses [Dijkstra's_algorithm](https://en.wikipedia.org/wiki/Dijkstra's_algorithm) to find the
+// cheapest path from the start to any node matching the goal. The path includes both the start and the
+// goal, ok is false if no goal can be reached
+func Dijkstra[N comparable, C slices.Number](start N, isGoal func(N) bool, neighbours func(N) []Edge[N, C]) (cost C, path []N, ok bool) {
+	return AStar(start, isGoal, neighbours, func(N) C { return 0 })
+}
+
+// AStar uses [A*](https://en.wikipedia.org/wiki/A*_search_algorithm) to find the cheapest path from the start
+// to any node matching the goal. The heuristic estimates the remaining cost to a goal, and must never
+// overestimate it for the result to be the cheapest path
+func AStar[N comparable, C slices.Number](start N, isGoal func(N) bool, neighbours func(N) []Edge[N, C], heuristic func(N) C) (cost C, path []N, ok bool) {
+	best := map[N]C{start: 0}
+	prev := make(map[N]N)
+	queue := &priorityQueue[N, C]{}
+	queue.push(start, 0, heuristic(start))
+
+	for queue.Len() > 0 {
+		current := queue.pop()
+
+		// Ignore entries that have been superseded by a cheaper route
+		if current.cost > best[current.node] {
+			continue
+		}
+		if isGoal(current.node) {
+			return current.cost, buildPath(prev, start, current.node), true
+		}
+
+		for _, edge := range neighbours(current.node) {
+			newCost := current.cost + edge.Cost
+			if existing, seen := best[edge.To]; seen && newCost >= existing {
+				continue
+			}
+			best[edge.To] = newCost
+			prev[edge.To] = current.node
+			queue.push(edge.To, newCost, newCost+heuristic(edge.To))
+		}
+	}
+	return cost, nil, false
+}
+
+// BFS uses a breadth first search to find the path with the fewest steps from the start to any node
+// matching the goal. The path includes both the start and the goal, ok is false if no goal can be reached
+func BFS[N comparable](start N, isGoal func(N) bool, neighbours func(N) []N) (steps int, path []N, ok bool) {
+	prev := make(map[N]N)
+	seen := map[N]struct{}{start: {}}
+	queue := []N{start}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if isGoal(current) {
+			path = buildPath(prev, start, current)
+			return len(path) - 1, path, true
+		}
+
+		for _, next := range neighbours(current) {
+			if _, ok := seen[next]; ok {
+				continue
+			}
+			seen[next] = struct{}{}
+			prev[next] = current
+			queue = append(queue, next)
+		}
+	}
+	return 0, nil, false
+}
+
+// buildPath follows the previous nodes back from the end to reconstruct the path from the start
+func buildPath[N comparable](prev map[N]N, start, end N) []N {
+	path := []N{end}
+	for current := end; current != start; {
+		current = prev[current]
+		path = append(path, current)
+	}
+
+	// Reverse so the path runs from start to end
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
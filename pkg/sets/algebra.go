@@ -0,0 +1,136 @@
+package sets
+
+// Len returns the number of elements in the set
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+// Clone creates a copy of the set that can be modified independently
+func (s Set[T]) Clone() Set[T] {
+	result := make(Set[T], len(s))
+	for k := range s {
+		result.Add(k)
+	}
+	return result
+}
+
+// Union will generate a new set containing elements in either set
+func (s Set[T]) Union(other Set[T]) Set[T] {
+	return Union(s, other)
+}
+
+// Intersection will generate a new set containing elements in both sets
+func (s Set[T]) Intersection(other Set[T]) Set[T] {
+	return Intersection(s, other)
+}
+
+// Difference will generate a new set containing elements that are not in the other set
+func (s Set[T]) Difference(other Set[T]) Set[T] {
+	return Difference(s, other)
+}
+
+// SymmetricDifference will generate a new set containing elements in only one of the sets
+func (s Set[T]) SymmetricDifference(other Set[T]) Set[T] {
+	return SymmetricDifference(s, other)
+}
+
+// IsSubset returns true if every element of the set is in the other set
+func (s Set[T]) IsSubset(other Set[T]) bool {
+	if len(s) > len(other) {
+		return false
+	}
+	for k := range s {
+		if !other.IsMember(k) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset returns true if every element of the other set is in the set
+func (s Set[T]) IsSuperset(other Set[T]) bool {
+	return other.IsSubset(s)
+}
+
+// Equal returns true if both sets contain the same elements
+func (s Set[T]) Equal(other Set[T]) bool {
+	return len(s) == len(other) && s.IsSubset(other)
+}
+
+// Union will generate a new set containing elements in any of the sets
+func Union[T comparable](sets ...Set[T]) Set[T] {
+	result := NewEmptySet[T]()
+	for _, s := range sets {
+		for k := range s {
+			result.Add(k)
+		}
+	}
+	return result
+}
+
+// Intersection will generate a new set containing elements in every one of the sets
+func Intersection[T comparable](sets ...Set[T]) Set[T] {
+	result := NewEmptySet[T]()
+	if len(sets) == 0 {
+		return result
+	}
+
+	// Only the smallest set needs to be checked against the others
+	smallest := sets[0]
+	for _, s := range sets[1:] {
+		if len(s) < len(smallest) {
+			smallest = s
+		}
+	}
+	for k := range smallest {
+		inAll := true
+		for _, s := range sets {
+			if !s.IsMember(k) {
+				inAll = false
+				break
+			}
+		}
+		if inAll {
+			result.Add(k)
+		}
+	}
+	return result
+}
+
+// Difference will generate a new set containing elements in the first set that are not in any of the others
+func Difference[T comparable](first Set[T], others ...Set[T]) Set[T] {
+	return first.Filter(func(val T) bool {
+		for _, s := range others {
+			if s.IsMember(val) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// SymmetricDifference will generate a new set containing elements that are in an odd number of the sets,
+// for two sets these are the elements in only one of them
+func SymmetricDifference[T comparable](sets ...Set[T]) Set[T] {
+	result := NewEmptySet[T]()
+	for _, s := range sets {
+		for k := range s {
+			if result.IsMember(k) {
+				result.Remove(k)
+			} else {
+				result.Add(k)
+			}
+		}
+	}
+	return result
+}
+
+// Equal returns true if all the sets contain the same elements
+func Equal[T comparable](sets ...Set[T]) bool {
+	for i := 1; i < len(sets); i++ {
+		if !sets[i].Equal(sets[0]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,192 @@
+package sets
+
+import (
+	"reflect"
+	"testing"
+)
+
+func set(entries ...int) Set[int] {
+	return NewSetFromSlice(entries)
+}
+
+// assertSet compares using reflect.DeepEqual so the checks don't depend on the Equal being tested
+func assertSet(t *testing.T, name string, got, want Set[int]) {
+	t.Helper()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("%s: got %v, want %v", name, got.ToSlice(), want.ToSlice())
+	}
+}
+
+func TestUnion(t *testing.T) {
+	tests := []struct {
+		name string
+		sets []Set[int]
+		want Set[int]
+	}{
+		{"no sets", nil, set()},
+		{"single set", []Set[int]{set(1, 2)}, set(1, 2)},
+		{"both empty", []Set[int]{set(), set()}, set()},
+		{"one empty", []Set[int]{set(1, 2), set()}, set(1, 2)},
+		{"overlapping", []Set[int]{set(1, 2, 3), set(3, 4)}, set(1, 2, 3, 4)},
+		{"three sets", []Set[int]{set(1), set(2), set(1, 3)}, set(1, 2, 3)},
+	}
+	for _, tc := range tests {
+		assertSet(t, tc.name, Union(tc.sets...), tc.want)
+		if len(tc.sets) == 2 {
+			assertSet(t, tc.name+" (method)", tc.sets[0].Union(tc.sets[1]), tc.want)
+		}
+	}
+}
+
+func TestIntersection(t *testing.T) {
+	tests := []struct {
+		name string
+		sets []Set[int]
+		want Set[int]
+	}{
+		{"no sets is empty", nil, set()},
+		{"single set", []Set[int]{set(1, 2)}, set(1, 2)},
+		{"both empty", []Set[int]{set(), set()}, set()},
+		{"one empty", []Set[int]{set(1, 2), set()}, set()},
+		{"overlapping", []Set[int]{set(1, 2, 3), set(2, 3, 4)}, set(2, 3)},
+		{"disjoint", []Set[int]{set(1, 2), set(3, 4)}, set()},
+		{"three sets", []Set[int]{set(1, 2, 3, 4), set(2, 3, 4), set(3, 4, 5)}, set(3, 4)},
+	}
+	for _, tc := range tests {
+		assertSet(t, tc.name, Intersection(tc.sets...), tc.want)
+		if len(tc.sets) == 2 {
+			assertSet(t, tc.name+" (method)", tc.sets[0].Intersection(tc.sets[1]), tc.want)
+		}
+	}
+}
+
+func TestDifference(t *testing.T) {
+	tests := []struct {
+		name   string
+		first  Set[int]
+		others []Set[int]
+		want   Set[int]
+	}{
+		{"no others", set(1, 2), nil, set(1, 2)},
+		{"empty first", set(), []Set[int]{set(1)}, set()},
+		{"empty other", set(1, 2), []Set[int]{set()}, set(1, 2)},
+		{"overlapping", set(1, 2, 3), []Set[int]{set(2, 4)}, set(1, 3)},
+		{"subset removes all", set(1, 2), []Set[int]{set(1, 2, 3)}, set()},
+		{"several others", set(1, 2, 3, 4), []Set[int]{set(1), set(3, 5)}, set(2, 4)},
+	}
+	for _, tc := range tests {
+		assertSet(t, tc.name, Difference(tc.first, tc.others...), tc.want)
+		if len(tc.others) == 1 {
+			assertSet(t, tc.name+" (method)", tc.first.Difference(tc.others[0]), tc.want)
+		}
+	}
+}
+
+func TestSymmetricDifference(t *testing.T) {
+	tests := []struct {
+		name string
+		sets []Set[int]
+		want Set[int]
+	}{
+		{"no sets", nil, set()},
+		{"single set", []Set[int]{set(1, 2)}, set(1, 2)},
+		{"both empty", []Set[int]{set(), set()}, set()},
+		{"one empty", []Set[int]{set(1, 2), set()}, set(1, 2)},
+		{"overlapping", []Set[int]{set(1, 2, 3), set(3, 4)}, set(1, 2, 4)},
+		{"identical", []Set[int]{set(1, 2), set(1, 2)}, set()},
+		{"odd number of sets", []Set[int]{set(1, 2), set(2, 3), set(2, 4)}, set(1, 2, 3, 4)},
+	}
+	for _, tc := range tests {
+		assertSet(t, tc.name, SymmetricDifference(tc.sets...), tc.want)
+		if len(tc.sets) == 2 {
+			assertSet(t, tc.name+" (method)", tc.sets[0].SymmetricDifference(tc.sets[1]), tc.want)
+		}
+	}
+}
+
+func TestIsSubsetAndIsSuperset(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     Set[int]
+		subset   bool
+		superset bool
+	}{
+		{"both empty", set(), set(), true, true},
+		{"empty is subset", set(), set(1), true, false},
+		{"equal", set(1, 2), set(1, 2), true, true},
+		{"proper subset", set(1), set(1, 2), true, false},
+		{"proper superset", set(1, 2, 3), set(2, 3), false, true},
+		{"overlapping", set(1, 2), set(2, 3), false, false},
+		{"same size different", set(1, 2), set(3, 4), false, false},
+	}
+	for _, tc := range tests {
+		if got := tc.a.IsSubset(tc.b); got != tc.subset {
+			t.Errorf("%s: IsSubset got %v, want %v", tc.name, got, tc.subset)
+		}
+		if got := tc.a.IsSuperset(tc.b); got != tc.superset {
+			t.Errorf("%s: IsSuperset got %v, want %v", tc.name, got, tc.superset)
+		}
+	}
+}
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		sets []Set[int]
+		want bool
+	}{
+		{"no sets is equal", nil, true},
+		{"single set", []Set[int]{set(1)}, true},
+		{"both empty", []Set[int]{set(), set()}, true},
+		{"empty and non empty", []Set[int]{set(), set(1)}, false},
+		{"same elements", []Set[int]{set(1, 2), set(2, 1)}, true},
+		{"same size different", []Set[int]{set(1, 2), set(1, 3)}, false},
+		{"subset", []Set[int]{set(1), set(1, 2)}, false},
+		{"three equal", []Set[int]{set(1, 2), set(1, 2), set(2, 1)}, true},
+		{"last differs", []Set[int]{set(1, 2), set(1, 2), set(1)}, false},
+	}
+	for _, tc := range tests {
+		if got := Equal(tc.sets...); got != tc.want {
+			t.Errorf("%s: got %v, want %v", tc.name, got, tc.want)
+		}
+		if len(tc.sets) == 2 {
+			if got := tc.sets[0].Equal(tc.sets[1]); got != tc.want {
+				t.Errorf("%s (method): got %v, want %v", tc.name, got, tc.want)
+			}
+		}
+	}
+}
+
+func TestCloneAndLen(t *testing.T) {
+	tests := []struct {
+		name string
+		set  Set[int]
+		len  int
+	}{
+		{"empty", set(), 0},
+		{"single", set(1), 1},
+		{"deduped", NewSetFromSlice([]int{1, 2, 2, 3}), 3},
+	}
+	for _, tc := range tests {
+		if got := tc.set.Len(); got != tc.len {
+			t.Errorf("%s: Len got %d, want %d", tc.name, got, tc.len)
+		}
+
+		clone := tc.set.Clone()
+		assertSet(t, tc.name+" clone", clone, tc.set)
+		clone.Add(100)
+		if tc.set.IsMember(100) || tc.set.Len() != tc.len {
+			t.Errorf("%s: modifying the clone changed the original", tc.name)
+		}
+	}
+}
+
+func TestMethodsDoNotModifyReceivers(t *testing.T) {
+	a, b := set(1, 2, 3), set(3, 4)
+	a.Union(b)
+	a.Intersection(b)
+	a.Difference(b)
+	a.SymmetricDifference(b)
+	assertSet(t, "receiver", a, set(1, 2, 3))
+	assertSet(t, "argument", b, set(3, 4))
+}
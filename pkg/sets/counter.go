@@ -0,0 +1,173 @@
+package sets
+
+import (
+	"adventofcode2021/pkg/tuples"
+	"sort"
+)
+
+// Counter is a multiset, tracking how many times each element has been added. Only elements with a
+// positive count are stored
+type Counter[T comparable] map[T]int
+
+// NewCounter generates an empty counter
+func NewCounter[T comparable]() Counter[T] {
+	return make(Counter[T])
+}
+
+// NewCounterFromSlice generates a counter with a count of each element in the provided slice
+func NewCounterFromSlice[T comparable](data []T) Counter[T] {
+	result := NewCounter[T]()
+	result.AddSlice(data)
+	return result
+}
+
+// Add will increase the count of an element by n, a negative n decreases the count and the element is
+// removed once its count is no longer positive
+func (c Counter[T]) Add(entry T, n int) {
+	count := c[entry] + n
+	if count > 0 {
+		c[entry] = count
+	} else {
+		delete(c, entry)
+	}
+}
+
+// AddSlice will increase the count of each element in the slice by one
+func (c Counter[T]) AddSlice(entries []T) {
+	for _, entry := range entries {
+		c.Add(entry, 1)
+	}
+}
+
+// Remove will remove an element from the counter regardless of its count
+func (c Counter[T]) Remove(entry T) {
+	delete(c, entry)
+}
+
+// Count returns the number of times the element has been added, zero if it isn't in the counter
+func (c Counter[T]) Count(entry T) int {
+	return c[entry]
+}
+
+// Len returns the number of distinct elements in the counter
+func (c Counter[T]) Len() int {
+	return len(c)
+}
+
+// Total returns the sum of the counts of all elements
+func (c Counter[T]) Total() int {
+	total := 0
+	for _, count := range c {
+		total += count
+	}
+	return total
+}
+
+// Clone creates a copy of the counter that can be modified independently
+func (c Counter[T]) Clone() Counter[T] {
+	result := make(Counter[T], len(c))
+	for k, count := range c {
+		result[k] = count
+	}
+	return result
+}
+
+// Keys returns a set of the distinct elements in the counter
+func (c Counter[T]) Keys() Set[T] {
+	result := NewEmptySet[T]()
+	for k := range c {
+		result.Add(k)
+	}
+	return result
+}
+
+// sorted returns every element and its count, ordered by count using the provided comparison. Elements
+// with equal counts are in an undefined order
+func (c Counter[T]) sorted(before func(x, y int) bool) []tuples.Pair[T, int] {
+	result := make([]tuples.Pair[T, int], 0, len(c))
+	for k, count := range c {
+		result = append(result, tuples.Pair[T, int]{Key: k, Value: count})
+	}
+	sort.Slice(result, func(i, j int) bool { return before(result[i].Value, result[j].Value) })
+	return result
+}
+
+// limit trims the entries to the first k, a k of zero or less keeps every entry
+func limit[T comparable](entries []tuples.Pair[T, int], k int) []tuples.Pair[T, int] {
+	if k > 0 && k < len(entries) {
+		return entries[:k]
+	}
+	return entries
+}
+
+// MostCommon returns the k elements with the highest counts, highest first. A k of zero or less returns
+// every element. Elements with equal counts are in an undefined order
+func (c Counter[T]) MostCommon(k int) []tuples.Pair[T, int] {
+	return limit(c.sorted(func(x, y int) bool { return x > y }), k)
+}
+
+// LeastCommon returns the k elements with the lowest counts, lowest first. A k of zero or less returns
+// every element. Elements with equal counts are in an undefined order
+func (c Counter[T]) LeastCommon(k int) []tuples.Pair[T, int] {
+	return limit(c.sorted(func(x, y int) bool { return x < y }), k)
+}
+
+// ForEachByCount calls the function for every element, highest count first
+func (c Counter[T]) ForEachByCount(op func(entry T, count int)) {
+	for _, p := range c.MostCommon(0) {
+		op(p.Key, p.Value)
+	}
+}
+
+// Plus will generate a new counter with the counts of both counters added together
+func (c Counter[T]) Plus(other Counter[T]) Counter[T] {
+	result := c.Clone()
+	for k, count := range other {
+		result.Add(k, count)
+	}
+	return result
+}
+
+// Minus will generate a new counter with the counts of the other counter subtracted, elements whose
+// count drops to zero or below are removed
+func (c Counter[T]) Minus(other Counter[T]) Counter[T] {
+	result := c.Clone()
+	for k, count := range other {
+		result.Add(k, -count)
+	}
+	return result
+}
+
+// Scale will generate a new counter with every count multiplied by n, a factor of zero or less
+// generates an empty counter
+func (c Counter[T]) Scale(n int) Counter[T] {
+	result := NewCounter[T]()
+	for k, count := range c {
+		result.Add(k, count*n)
+	}
+	return result
+}
+
+// Union will generate a new counter with the larger count of each element from either counter
+func (c Counter[T]) Union(other Counter[T]) Counter[T] {
+	result := c.Clone()
+	for k, count := range other {
+		if count > result[k] {
+			result[k] = count
+		}
+	}
+	return result
+}
+
+// Intersection will generate a new counter with the smaller count of each element in both counters
+func (c Counter[T]) Intersection(other Counter[T]) Counter[T] {
+	result := NewCounter[T]()
+	for k, count := range c {
+		if otherCount := other[k]; otherCount < count {
+			result.Add(k, otherCount)
+		} else {
+			result.Add(k, count)
+		}
+	}
+	return result
+}
@@ -0,0 +1,152 @@
+package sets
+
+// DisjointSet partitions elements into non-overlapping components that can be merged (union-find).
+// Lookups use path compression and merges use union by rank, so operations are close to constant time
+type DisjointSet[T comparable] struct {
+	index    map[T]int
+	elements []T
+	parent   []int
+	rank     []int
+	size     []int
+	count    int
+}
+
+// NewDisjointSet generates an empty disjoint set
+func NewDisjointSet[T comparable]() *DisjointSet[T] {
+	return &DisjointSet[T]{index: make(map[T]int)}
+}
+
+// NewDisjointSetFromSlice generates a disjoint set with each element of the slice in its own component
+func NewDisjointSetFromSlice[T comparable](data []T) *DisjointSet[T] {
+	result := NewDisjointSet[T]()
+	for _, entry := range data {
+		result.Add(entry)
+	}
+	return result
+}
+
+// Add will add an element in its own component, elements already in the set are left unchanged
+func (d *DisjointSet[T]) Add(entry T) {
+	d.indexOf(entry)
+}
+
+// indexOf returns the position of the element, adding it first if required
+func (d *DisjointSet[T]) indexOf(entry T) int {
+	if i, ok := d.index[entry]; ok {
+		return i
+	}
+	i := len(d.elements)
+	d.index[entry] = i
+	d.elements = append(d.elements, entry)
+	d.parent = append(d.parent, i)
+	d.rank = append(d.rank, 0)
+	d.size = append(d.size, 1)
+	d.count++
+	return i
+}
+
+// root returns the position of the representative of the component, pointing every position visited
+// directly at it
+func (d *DisjointSet[T]) root(i int) int {
+	r := i
+	for d.parent[r] != r {
+		r = d.parent[r]
+	}
+	for d.parent[i] != r {
+		d.parent[i], i = r, d.parent[i]
+	}
+	return r
+}
+
+// IsMember returns true if the element has been added
+func (d *DisjointSet[T]) IsMember(entry T) bool {
+	_, ok := d.index[entry]
+	return ok
+}
+
+// Find returns the representative element of the component containing the element, adding the
+// element in its own component if required
+func (d *DisjointSet[T]) Find(entry T) T {
+	return d.elements[d.root(d.indexOf(entry))]
+}
+
+// Union will merge the components containing both elements, adding either element if required.
+// Returns false if they were already in the same component
+func (d *DisjointSet[T]) Union(a, b T) bool {
+	rootA, rootB := d.root(d.indexOf(a)), d.root(d.indexOf(b))
+	if rootA == rootB {
+		return false
+	}
+
+	// Attach the shallower tree below the deeper one to keep lookups short
+	if d.rank[rootA] < d.rank[rootB] {
+		rootA, rootB = rootB, rootA
+	}
+	d.parent[rootB] = rootA
+	d.size[rootA] += d.size[rootB]
+	if d.rank[rootA] == d.rank[rootB] {
+		d.rank[rootA]++
+	}
+	d.count--
+	return true
+}
+
+// Connected returns true if both elements are in the same component, elements not in the set are
+// never connected
+func (d *DisjointSet[T]) Connected(a, b T) bool {
+	i, okA := d.index[a]
+	j, okB := d.index[b]
+	return okA && okB && d.root(i) == d.root(j)
+}
+
+// Size returns the number of elements in the component containing the element, zero if the element
+// isn't in the set
+func (d *DisjointSet[T]) Size(entry T) int {
+	i, ok := d.index[entry]
+	if !ok {
+		return 0
+	}
+	return d.size[d.root(i)]
+}
+
+// Len returns the number of elements in the set
+func (d *DisjointSet[T]) Len() int {
+	return len(d.elements)
+}
+
+// Count returns the number of components
+func (d *DisjointSet[T]) Count() int {
+	return d.count
+}
+
+// Components returns the elements of each component. Components are ordered by the first of their
+// elements to be added, and elements within a component by the order they were added
+func (d *DisjointSet[T]) Components() [][]T {
+	result := [][]T{}
+	position := make(map[int]int, d.count)
+	for i, entry := range d.elements {
+		r := d.root(i)
+		p, ok := position[r]
+		if !ok {
+			p = len(result)
+			position[r] = p
+			result = append(result, make([]T, 0, d.size[r]))
+		}
+		result[p] = append(result[p], entry)
+	}
+	return result
+}
+
+// Sizes returns the number of elements in each component, in the same order as Components
+func (d *DisjointSet[T]) Sizes() []int {
+	result := []int{}
+	seen := make(map[int]struct{}, d.count)
+	for i := range d.elements {
+		r := d.root(i)
+		if _, ok := seen[r]; !ok {
+			seen[r] = struct{}{}
+			result = append(result, d.size[r])
+		}
+	}
+	return result
+}
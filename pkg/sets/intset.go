@@ -0,0 +1,248 @@
+package sets
+
+import (
+	"fmt"
+	mathbits "math/bits"
+)
+
+const intSetWordSize = 64
+
+// IntSet is a set of non-negative integers stored as a bitset, much faster than Set for small dense
+// values such as grid indices. Memory grows with the largest element rather than the number of elements
+type IntSet struct {
+	words []uint64
+}
+
+// NewIntSet generates an empty int set
+func NewIntSet() *IntSet {
+	return &IntSet{}
+}
+
+// NewIntSetFromSlice generates an int set based on a provided slice, any repeated elements will be deduped
+func NewIntSetFromSlice(data []int) *IntSet {
+	result := NewIntSet()
+	result.AddSlice(data)
+	return result
+}
+
+// intSetIndex converts an element to the word and bit holding it
+func intSetIndex(entry int) (int, uint) {
+	if entry < 0 {
+		panic(fmt.Sprintf("int set can not contain negative value %d", entry))
+	}
+	return entry / intSetWordSize, uint(entry % intSetWordSize)
+}
+
+// trim drops trailing empty words so sets with the same elements have the same representation
+func (s *IntSet) trim() {
+	end := len(s.words)
+	for end > 0 && s.words[end-1] == 0 {
+		end--
+	}
+	s.words = s.words[:end]
+}
+
+// Add will add an element to a set
+func (s *IntSet) Add(entry int) {
+	w, bit := intSetIndex(entry)
+	if w >= len(s.words) {
+		// Append so capacity grows geometrically, keeping ascending inserts linear
+		s.words = append(s.words, make([]uint64, w+1-len(s.words))...)
+	}
+	s.words[w] |= 1 << bit
+}
+
+// AddSlice will add multiple elements to a set
+func (s *IntSet) AddSlice(entries []int) {
+	for _, entry := range entries {
+		s.Add(entry)
+	}
+}
+
+// Remove will remove an element from the set
+func (s *IntSet) Remove(entry int) {
+	w, bit := intSetIndex(entry)
+	if w < len(s.words) {
+		s.words[w] &^= 1 << bit
+		s.trim()
+	}
+}
+
+// IsMember returns true if the element is in the set
+func (s *IntSet) IsMember(entry int) bool {
+	if entry < 0 {
+		return false
+	}
+	w, bit := intSetIndex(entry)
+	return w < len(s.words) && s.words[w]&(1<<bit) != 0
+}
+
+// PopCount returns the number of elements in the set
+func (s *IntSet) PopCount() int {
+	count := 0
+	for _, w := range s.words {
+		count += mathbits.OnesCount64(w)
+	}
+	return count
+}
+
+// Len returns the number of elements in the set
+func (s *IntSet) Len() int {
+	return s.PopCount()
+}
+
+// ForEach calls the function for every element in ascending order
+func (s *IntSet) ForEach(op func(entry int)) {
+	for i, w := range s.words {
+		for w != 0 {
+			bit := mathbits.TrailingZeros64(w)
+			op(i*intSetWordSize + bit)
+			w &= w - 1
+		}
+	}
+}
+
+// ToSlice will generate a slice with all the set elements in ascending order
+func (s *IntSet) ToSlice() []int {
+	result := make([]int, 0, s.PopCount())
+	s.ForEach(func(entry int) { result = append(result, entry) })
+	return result
+}
+
+// Min returns the smallest element, false if the set is empty
+func (s *IntSet) Min() (int, bool) {
+	for i, w := range s.words {
+		if w != 0 {
+			return i*intSetWordSize + mathbits.TrailingZeros64(w), true
+		}
+	}
+	return 0, false
+}
+
+// Max returns the largest element, false if the set is empty
+func (s *IntSet) Max() (int, bool) {
+	if len(s.words) == 0 {
+		return 0, false
+	}
+	i := len(s.words) - 1
+	return i*intSetWordSize + intSetWordSize - 1 - mathbits.LeadingZeros64(s.words[i]), true
+}
+
+// Filter will generate a new set containing elements that match the predicate
+func (s *IntSet) Filter(predicate func(val int) bool) *IntSet {
+	result := NewIntSet()
+	s.ForEach(func(entry int) {
+		if predicate(entry) {
+			result.Add(entry)
+		}
+	})
+	return result
+}
+
+// SumWeighted will sum all values in the set using the provided weighting function
+func (s *IntSet) SumWeighted(weightFunc func(x int) int) int {
+	var sum int
+	s.ForEach(func(entry int) { sum += weightFunc(entry) })
+	return sum
+}
+
+// Clone creates a copy of the set that can be modified independently
+func (s *IntSet) Clone() *IntSet {
+	words := make([]uint64, len(s.words))
+	copy(words, s.words)
+	return &IntSet{words: words}
+}
+
+// combine generates a new set by applying the operation to each word of both sets, missing words are zero
+func (s *IntSet) combine(other *IntSet, op func(x, y uint64) uint64) *IntSet {
+	length := len(s.words)
+	if len(other.words) > length {
+		length = len(other.words)
+	}
+	result := &IntSet{words: make([]uint64, length)}
+	for i := range result.words {
+		var x, y uint64
+		if i < len(s.words) {
+			x = s.words[i]
+		}
+		if i < len(other.words) {
+			y = other.words[i]
+		}
+		result.words[i] = op(x, y)
+	}
+	result.trim()
+	return result
+}
+
+// Union will generate a new set containing elements in either set
+func (s *IntSet) Union(other *IntSet) *IntSet {
+	return s.combine(other, func(x, y uint64) uint64 { return x | y })
+}
+
+// Intersection will generate a new set containing elements in both sets
+func (s *IntSet) Intersection(other *IntSet) *IntSet {
+	return s.combine(other, func(x, y uint64) uint64 { return x & y })
+}
+
+// Difference will generate a new set containing elements that are not in the other set
+func (s *IntSet) Difference(other *IntSet) *IntSet {
+	return s.combine(other, func(x, y uint64) uint64 { return x &^ y })
+}
+
+// SymmetricDifference will generate a new set containing elements in only one of the sets
+func (s *IntSet) SymmetricDifference(other *IntSet) *IntSet {
+	return s.combine(other, func(x, y uint64) uint64 { return x ^ y })
+}
+
+// UnionWith will add every element of the other set to this set in place
+func (s *IntSet) UnionWith(other *IntSet) {
+	if len(other.words) > len(s.words) {
+		s.words = append(s.words, make([]uint64, len(other.words)-len(s.words))...)
+	}
+	for i, w := range other.words {
+		s.words[i] |= w
+	}
+}
+
+// IntersectWith will remove every element that isn't in the other set, modifying this set in place
+func (s *IntSet) IntersectWith(other *IntSet) {
+	for i := range s.words {
+		if i < len(other.words) {
+			s.words[i] &= other.words[i]
+		} else {
+			s.words[i] = 0
+		}
+	}
+	s.trim()
+}
+
+// IsSubset returns true if every element of the set is in the other set
+func (s *IntSet) IsSubset(other *IntSet) bool {
+	if len(s.words) > len(other.words) {
+		return false
+	}
+	for i, w := range s.words {
+		if w&^other.words[i] != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset returns true if every element of the other set is in the set
+func (s *IntSet) IsSuperset(other *IntSet) bool {
+	return other.IsSubset(s)
+}
+
+// Equal returns true if both sets contain the same elements
+func (s *IntSet) Equal(other *IntSet) bool {
+	if len(s.words) != len(other.words) {
+		return false
+	}
+	for i, w := range s.words {
+		if w != other.words[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,287 @@
+package sets
+
+import "constraints"
+
+// OrderedSet is a set where elements are kept in sorted order, backed by an AVL tree
+type OrderedSet[T any] struct {
+	root *orderedNode[T]
+	less func(a, b T) bool
+	size int
+}
+
+type orderedNode[T any] struct {
+	value       T
+	left, right *orderedNode[T]
+	height      int
+}
+
+// NewOrderedSet generates an empty set ordered from smallest to largest
+func NewOrderedSet[T constraints.Ordered]() *OrderedSet[T] {
+	return NewOrderedSetFunc(func(a, b T) bool { return a < b })
+}
+
+// NewOrderedSetFunc generates an empty set ordered by the provided comparator, elements are considered
+// equal if neither is less than the other
+func NewOrderedSetFunc[T any](less func(a, b T) bool) *OrderedSet[T] {
+	return &OrderedSet[T]{less: less}
+}
+
+// NewOrderedSetFromSlice generates an ordered set based on a provided slice, any repeated elements will be deduped
+func NewOrderedSetFromSlice[T constraints.Ordered](data []T) *OrderedSet[T] {
+	result := NewOrderedSet[T]()
+	result.AddSlice(data)
+	return result
+}
+
+// Len returns the number of elements in the set
+func (s *OrderedSet[T]) Len() int {
+	return s.size
+}
+
+// Add will add an element to a set
+func (s *OrderedSet[T]) Add(entry T) {
+	var added bool
+	s.root, added = s.insert(s.root, entry)
+	if added {
+		s.size++
+	}
+}
+
+// AddSlice will add multiple elements to a set
+func (s *OrderedSet[T]) AddSlice(entries []T) {
+	for _, entry := range entries {
+		s.Add(entry)
+	}
+}
+
+// Remove will remove an element from the set
+func (s *OrderedSet[T]) Remove(entry T) {
+	var removed bool
+	s.root, removed = s.remove(s.root, entry)
+	if removed {
+		s.size--
+	}
+}
+
+// IsMember returns true if the element is in the set
+func (s *OrderedSet[T]) IsMember(val T) bool {
+	n := s.root
+	for n != nil {
+		switch {
+		case s.less(val, n.value):
+			n = n.left
+		case s.less(n.value, val):
+			n = n.right
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// ForEach performs the operation on every element in order
+func (s *OrderedSet[T]) ForEach(op func(val T)) {
+	var walk func(n *orderedNode[T])
+	walk = func(n *orderedNode[T]) {
+		if n == nil {
+			return
+		}
+		walk(n.left)
+		op(n.value)
+		walk(n.right)
+	}
+	walk(s.root)
+}
+
+// ToSlice will generate a slice with all the set elements in order
+func (s *OrderedSet[T]) ToSlice() []T {
+	result := make([]T, 0, s.size)
+	s.ForEach(func(val T) {
+		result = append(result, val)
+	})
+	return result
+}
+
+// Filter will generate a new set containing elements that match the predicate
+func (s *OrderedSet[T]) Filter(predicate func(val T) bool) *OrderedSet[T] {
+	result := NewOrderedSetFunc(s.less)
+	s.ForEach(func(val T) {
+		if predicate(val) {
+			result.Add(val)
+		}
+	})
+	return result
+}
+
+// Min returns the smallest element, ok is false if the set is empty
+func (s *OrderedSet[T]) Min() (T, bool) {
+	if s.root == nil {
+		var blank T
+		return blank, false
+	}
+	n := s.root
+	for n.left != nil {
+		n = n.left
+	}
+	return n.value, true
+}
+
+// Max returns the largest element, ok is false if the set is empty
+func (s *OrderedSet[T]) Max() (T, bool) {
+	if s.root == nil {
+		var blank T
+		return blank, false
+	}
+	n := s.root
+	for n.right != nil {
+		n = n.right
+	}
+	return n.value, true
+}
+
+// Floor returns the largest element less than or equal to the value, ok is false if there is none
+func (s *OrderedSet[T]) Floor(val T) (result T, ok bool) {
+	n := s.root
+	for n != nil {
+		if s.less(val, n.value) {
+			n = n.left
+		} else {
+			result, ok = n.value, true
+			n = n.right
+		}
+	}
+	return result, ok
+}
+
+// Ceiling returns the smallest element greater than or equal to the value, ok is false if there is none
+func (s *OrderedSet[T]) Ceiling(val T) (result T, ok bool) {
+	n := s.root
+	for n != nil {
+		if s.less(n.value, val) {
+			n = n.right
+		} else {
+			result, ok = n.value, true
+			n = n.left
+		}
+	}
+	return result, ok
+}
+
+// Range returns the elements between from and to (inclusive) in order
+func (s *OrderedSet[T]) Range(from, to T) []T {
+	result := []T{}
+	var walk func(n *orderedNode[T])
+	walk = func(n *orderedNode[T]) {
+		if n == nil {
+			return
+		}
+		aboveFrom := !s.less(n.value, from)
+		belowTo := !s.less(to, n.value)
+		if aboveFrom {
+			walk(n.left)
+		}
+		if aboveFrom && belowTo {
+			result = append(result, n.value)
+		}
+		if belowTo {
+			walk(n.right)
+		}
+	}
+	walk(s.root)
+	return result
+}
+
+func nodeHeight[T any](n *orderedNode[T]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func (n *orderedNode[T]) updateHeight() {
+	n.height = nodeHeight(n.left) + 1
+	if right := nodeHeight(n.right) + 1; right > n.height {
+		n.height = right
+	}
+}
+
+func rotateLeft[T any](n *orderedNode[T]) *orderedNode[T] {
+	pivot := n.right
+	n.right = pivot.left
+	pivot.left = n
+	n.updateHeight()
+	pivot.updateHeight()
+	return pivot
+}
+
+func rotateRight[T any](n *orderedNode[T]) *orderedNode[T] {
+	pivot := n.left
+	n.left = pivot.right
+	pivot.right = n
+	n.updateHeight()
+	pivot.updateHeight()
+	return pivot
+}
+
+// rebalance restores the AVL property, where the heights of the children differ by at most 1
+func rebalance[T any](n *orderedNode[T]) *orderedNode[T] {
+	n.updateHeight()
+	switch balance := nodeHeight(n.left) - nodeHeight(n.right); {
+	case balance > 1:
+		if nodeHeight(n.left.left) < nodeHeight(n.left.right) {
+			n.left = rotateLeft(n.left)
+		}
+		return rotateRight(n)
+	case balance < -1:
+		if nodeHeight(n.right.right) < nodeHeight(n.right.left) {
+			n.right = rotateRight(n.right)
+		}
+		return rotateLeft(n)
+	}
+	return n
+}
+
+func (s *OrderedSet[T]) insert(n *orderedNode[T], val T) (*orderedNode[T], bool) {
+	if n == nil {
+		return &orderedNode[T]{value: val, height: 1}, true
+	}
+	var added bool
+	switch {
+	case s.less(val, n.value):
+		n.left, added = s.insert(n.left, val)
+	case s.less(n.value, val):
+		n.right, added = s.insert(n.right, val)
+	default:
+		return n, false
+	}
+	return rebalance(n), added
+}
+
+func (s *OrderedSet[T]) remove(n *orderedNode[T], val T) (*orderedNode[T], bool) {
+	if n == nil {
+		return nil, false
+	}
+	var removed bool
+	switch {
+	case s.less(val, n.value):
+		n.left, removed = s.remove(n.left, val)
+	case s.less(n.value, val):
+		n.right, removed = s.remove(n.right, val)
+	default:
+		if n.left == nil {
+			return n.right, true
+		}
+		if n.right == nil {
+			return n.left, true
+		}
+		// Replace with the smallest element of the right subtree
+		successor := n.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+		n.value = successor.value
+		n.right, _ = s.remove(n.right, successor.value)
+		removed = true
+	}
+	return rebalance(n), removed
+}
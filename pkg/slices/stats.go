@@ -0,0 +1,146 @@
+package slices
+
+import (
+	"constraints"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Number is any integer or floating point type
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
+// sortedCopy returns a sorted copy of the slice, panicking if it is empty
+func sortedCopy[T constraints.Ordered](source []T) []T {
+	if len(source) == 0 {
+		panic("No entries in slice")
+	}
+	result := make([]T, len(source))
+	copy(result, source)
+	sort.Slice(result, func(i, j int) bool {
+		return result[i] < result[j]
+	})
+	return result
+}
+
+// Median will return the median value. For even length slices the lower of the two middle values is
+// returned, so the result is always an element of the slice
+func Median[T constraints.Ordered](source []T) T {
+	sorted := sortedCopy(source)
+	return sorted[(len(sorted)-1)/2]
+}
+
+// MedianFloat will return the median value. For even length slices the mean of the two middle values
+// is returned
+func MedianFloat[T Number](source []T) float64 {
+	sorted := sortedCopy(source)
+	n := len(sorted)
+	if n%2 == 1 {
+		return float64(sorted[n/2])
+	}
+	return (float64(sorted[n/2-1]) + float64(sorted[n/2])) / 2
+}
+
+// Mean will return the arithmetic mean of all values
+func Mean[T Number](source []T) float64 {
+	if len(source) == 0 {
+		panic("No entries in slice")
+	}
+	var sum float64
+	for _, v := range source {
+		sum += float64(v)
+	}
+	return sum / float64(len(source))
+}
+
+// MeanInt will return the arithmetic mean of all values, rounded down to a whole number
+func MeanInt[T constraints.Integer](source []T) T {
+	if len(source) == 0 {
+		panic("No entries in slice")
+	}
+	n := T(len(source))
+	sum := Sum(source)
+	mean := sum / n
+	// Integer division rounds towards zero, so negative results need adjusting down
+	if sum%n != 0 && sum < 0 {
+		mean--
+	}
+	return mean
+}
+
+// Mode will return the most common value. If several values are equally common, the one that
+// appears first is returned
+func Mode[T comparable](source []T) T {
+	if len(source) == 0 {
+		panic("No entries in slice")
+	}
+	counts := make(map[T]int)
+	for _, v := range source {
+		counts[v]++
+	}
+	mode, maxCount := source[0], 0
+	for _, v := range source {
+		if counts[v] > maxCount {
+			mode, maxCount = v, counts[v]
+		}
+	}
+	return mode
+}
+
+// Variance will return the population variance of all values
+func Variance[T Number](source []T) float64 {
+	mean := Mean(source)
+	var sum float64
+	for _, v := range source {
+		diff := float64(v) - mean
+		sum += diff * diff
+	}
+	return sum / float64(len(source))
+}
+
+// StdDev will return the population standard deviation of all values
+func StdDev[T Number](source []T) float64 {
+	return math.Sqrt(Variance(source))
+}
+
+// quantileSorted interpolates linearly between the two closest ranks of an already sorted slice
+func quantileSorted[T Number](sorted []T, q float64) float64 {
+	if q < 0 || q > 1 {
+		panic(fmt.Sprintf("quantile %v out of range 0 to 1", q))
+	}
+	pos := q * float64(len(sorted)-1)
+	lower := int(math.Floor(pos))
+	if lower == len(sorted)-1 {
+		return float64(sorted[lower])
+	}
+	frac := pos - float64(lower)
+	return float64(sorted[lower]) + frac*(float64(sorted[lower+1])-float64(sorted[lower]))
+}
+
+// Quantile will return the value below which the fraction q (0 to 1) of values fall, interpolating
+// between values where required
+func Quantile[T Number](source []T, q float64) float64 {
+	return quantileSorted(sortedCopy(source), q)
+}
+
+// Percentile will return the value below which p percent (0 to 100) of values fall, interpolating
+// between values where required
+func Percentile[T Number](source []T, p float64) float64 {
+	return Quantile(source, p/100)
+}
+
+// Quantiles will return the n-1 cut points dividing the values into n equally sized groups
+// e.g. n=4 returns the quartiles
+func Quantiles[T Number](source []T, n int) []float64 {
+	if n < 1 {
+		panic(fmt.Sprintf("invalid number of quantiles %d", n))
+	}
+	sorted := sortedCopy(source)
+	result := make([]float64, n-1)
+	for i := range result {
+		result[i] = quantileSorted(sorted, float64(i+1)/float64(n))
+	}
+	return result
+}
@@ -0,0 +1,114 @@
+package slices
+
+import (
+	"adventofcode2021/pkg/tuples"
+	"fmt"
+)
+
+// Windows will return every run of size consecutive elements, in order. The windows share the
+// source's backing array, so must be copied before being modified
+func Windows[T any](source []T, size int) [][]T {
+	if size < 1 {
+		panic(fmt.Sprintf("invalid window size %d", size))
+	}
+	if size > len(source) {
+		return [][]T{}
+	}
+	result := make([][]T, len(source)-size+1)
+	for i := range result {
+		result[i] = source[i : i+size : i+size]
+	}
+	return result
+}
+
+// Chunks will split the slice into consecutive pieces of size elements, the final chunk holds any
+// remainder so may be shorter. The chunks share the source's backing array
+func Chunks[T any](source []T, size int) [][]T {
+	if size < 1 {
+		panic(fmt.Sprintf("invalid chunk size %d", size))
+	}
+	result := make([][]T, 0, (len(source)+size-1)/size)
+	for start := 0; start < len(source); start += size {
+		end := start + size
+		if end > len(source) {
+			end = len(source)
+		}
+		result = append(result, source[start:end:end])
+	}
+	return result
+}
+
+// Pairwise will return each element paired with the element that follows it
+func Pairwise[T any](source []T) []tuples.Pair[T, T] {
+	if len(source) < 2 {
+		return []tuples.Pair[T, T]{}
+	}
+	result := make([]tuples.Pair[T, T], len(source)-1)
+	for i := range result {
+		result[i] = tuples.Pair[T, T]{Key: source[i], Value: source[i+1]}
+	}
+	return result
+}
+
+// Zip will pair up the elements at the same position in both slices, stopping at the end of the
+// shorter slice
+func Zip[T, U any](keys []T, values []U) []tuples.Pair[T, U] {
+	n := len(keys)
+	if len(values) < n {
+		n = len(values)
+	}
+	result := make([]tuples.Pair[T, U], n)
+	for i := range result {
+		result[i] = tuples.Pair[T, U]{Key: keys[i], Value: values[i]}
+	}
+	return result
+}
+
+// Unzip will split the pairs back into a slice of keys and a slice of values
+func Unzip[T, U any](pairs []tuples.Pair[T, U]) ([]T, []U) {
+	keys := make([]T, len(pairs))
+	values := make([]U, len(pairs))
+	for i, p := range pairs {
+		keys[i], values[i] = p.Key, p.Value
+	}
+	return keys, values
+}
+
+// Scan will return the running result of applying the accumulator to each element in turn, starting
+// from the initial value. The initial value itself isn't included
+func Scan[T, U any](source []T, initial U, accumulator func(acc U, val T) U) []U {
+	result := make([]U, len(source))
+	acc := initial
+	for i, v := range source {
+		acc = accumulator(acc, v)
+		result[i] = acc
+	}
+	return result
+}
+
+// PrefixSums will return the sum of the first i elements at each position i, including a leading zero,
+// so the sum of the elements from i up to (but excluding) j is result[j]-result[i]
+func PrefixSums[T Number](source []T) []T {
+	result := make([]T, len(source)+1)
+	for i, v := range source {
+		result[i+1] = result[i] + v
+	}
+	return result
+}
+
+// WindowSums will return the sum of every run of size consecutive elements, in order. Each sum is
+// calculated from the prefix sums rather than adding up the window again
+func WindowSums[T Number](source []T, size int) []T {
+	if size < 1 {
+		panic(fmt.Sprintf("invalid window size %d", size))
+	}
+	if size > len(source) {
+		return []T{}
+	}
+	prefix := PrefixSums(source)
+	result := make([]T, len(source)-size+1)
+	for i := range result {
+		result[i] = prefix[i+size] - prefix[i]
+	}
+	return result
+}
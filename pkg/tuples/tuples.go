@@ -1,10 +1,6 @@
 package tuples
 
-import (
-	"adventofcode2021/pkg/convert"
-)
-
-type Pair[T, U convert.Convertable] struct {
+type Pair[T, U any] struct {
 	Key   T
 	Value U
 }